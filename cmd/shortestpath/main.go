@@ -0,0 +1,271 @@
+// Command shortestpath answers shortest path queries on a graph file.
+//
+//	shortestpath -graph roads.gr [-algorithm uniform|astar|bidirectional|k] [-k 3]
+//		[-heuristic euclidean|manhattan|haversine|landmarks] [-landmarks 8]
+//		[-format text|json] from to
+//	shortestpath -graph roads.gr -batch < queries
+//
+// Graph files are read by extension, see the loader package. In batch mode
+// every line of stdin is a "from to" query, blank lines and lines starting
+// with # are skipped. A* needs a -heuristic. The euclidean, manhattan and
+// haversine heuristics need vertex coordinates in the units of the edge
+// costs, such as a DIMACS .co file, and are checked against every edge when
+// the graph is loaded. Landmarks are picked and measured instead
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/shortest_path/loader"
+)
+
+// path is one route of an answer
+type path struct {
+	Cost     int      `json:"cost"`
+	Vertices []string `json:"path"`
+}
+
+// answer to a query, Paths is empty when there is no path
+type answer struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Found bool   `json:"found"`
+	Paths []path `json:"paths"`
+	Error string `json:"error,omitempty"`
+}
+
+// engine answers queries with one algorithm
+type engine struct {
+	graph  *loader.Graph
+	search *shortest_path.Search[string, *loader.Edge, int]
+	k      int
+}
+
+func newEngine(graph *loader.Graph, algorithm, heuristic string, k, landmarks int) (*engine, error) {
+	e := &engine{graph: graph, k: 1}
+
+	switch algorithm {
+	case "uniform":
+		e.search = shortest_path.NewUniformCost[string, *loader.Edge](graph)
+	case "astar":
+		if heuristic == "" {
+			return nil, errors.New("astar needs -heuristic")
+		}
+		if heuristic == "landmarks" {
+			l, err := shortest_path.NewLandmarks[string, *loader.Edge](graph, graph.Vertices, shortest_path.LandmarkOptions{
+				Count: min(landmarks, len(graph.Vertices)),
+			})
+			if err != nil {
+				return nil, err
+			}
+			e.search = l.NewAStar()
+			break
+		}
+
+		if len(graph.Coordinates) == 0 {
+			return nil, errors.New("astar needs vertex coordinates")
+		}
+		var distance shortest_path.Heuristic[string, float64]
+		switch heuristic {
+		case "euclidean":
+			distance = shortest_path.Euclidean[float64](graph.Position)
+		case "manhattan":
+			distance = shortest_path.Manhattan[float64](graph.Position)
+		case "haversine":
+			distance = shortest_path.Haversine[float64](graph.Position)
+		default:
+			return nil, fmt.Errorf("unknown heuristic %q", heuristic)
+		}
+		if err := checkHeuristic(graph, distance); err != nil {
+			return nil, fmt.Errorf("%s heuristic does not fit the edge costs: %w", heuristic, err)
+		}
+		// truncated down, a distance that fits every edge still does
+		e.search = shortest_path.NewAStar[string, *loader.Edge](graph, func(vertex, goal string) int {
+			return int(distance(vertex, goal))
+		})
+	case "bidirectional":
+		e.search = shortest_path.NewBidirectional[string, *loader.Edge](graph)
+	case "k":
+		if k <= 0 {
+			return nil, fmt.Errorf("k must be positive, got %d", k)
+		}
+		e.search = shortest_path.NewUniformCost[string, *loader.Edge](graph)
+		e.k = k
+	default:
+		return nil, fmt.Errorf("unknown algorithm %q", algorithm)
+	}
+
+	return e, nil
+}
+
+// checkHeuristic fails if the distance overestimates an edge, A* could then
+// print paths that are not the cheapest. It compares the distance before it is
+// truncated to the int costs, small overestimates would add up along a path.
+// The coordinate heuristics are distances, so one that fits every edge is
+// consistent
+func checkHeuristic(graph *loader.Graph, distance shortest_path.Heuristic[string, float64]) error {
+	for _, from := range graph.Vertices {
+		for _, edge := range graph.Edges(from) {
+			to := graph.EdgeEnd(edge)
+			if estimate, cost := distance(from, to), float64(graph.EdgeCost(edge)); estimate > cost {
+				inadmissible := &shortest_path.Inadmissible[string, float64]{Vertex: from, Goal: to, Estimate: estimate, Cost: cost}
+				return errors.New(inadmissible.String())
+			}
+		}
+	}
+	return nil
+}
+
+func (e *engine) query(from, to string) *answer {
+	a := &answer{From: from, To: to, Paths: []path{}}
+	for _, vertex := range []string{from, to} {
+		if !e.graph.HasVertex(vertex) {
+			a.Error = fmt.Sprintf("unknown vertex %q", vertex)
+			return a
+		}
+	}
+
+	var found []*shortest_path.Path[string, *loader.Edge, int]
+	if e.k > 1 {
+		found = e.search.FindK(from, to, e.k)
+	} else {
+		found = append(found, e.search.Find(from, to))
+	}
+
+	for _, p := range found {
+		if p.Err != nil {
+			a.Error = p.Err.Error()
+			continue
+		}
+		if p.Found {
+			a.Paths = append(a.Paths, path{Cost: p.Cost, Vertices: p.Vertices})
+		}
+	}
+	a.Found = len(a.Paths) > 0
+	return a
+}
+
+// write prints an answer as text, or as one line of JSON
+func write(w io.Writer, format string, a *answer) error {
+	if format == "json" {
+		return json.NewEncoder(w).Encode(a)
+	}
+
+	switch {
+	case a.Error != "" && !a.Found:
+		_, err := fmt.Fprintf(w, "%s -> %s: error: %s\n", a.From, a.To, a.Error)
+		return err
+	case !a.Found:
+		_, err := fmt.Fprintf(w, "%s -> %s: no path\n", a.From, a.To)
+		return err
+	}
+	for i, p := range a.Paths {
+		prefix := ""
+		if len(a.Paths) > 1 {
+			prefix = fmt.Sprintf("#%d ", i+1)
+		}
+		if _, err := fmt.Fprintf(w, "%s%s -> %s: cost %d: %s\n", prefix, a.From, a.To, p.Cost, strings.Join(p.Vertices, " ")); err != nil {
+			return err
+		}
+	}
+	if a.Error != "" {
+		_, err := fmt.Fprintf(w, "%s -> %s: error: %s\n", a.From, a.To, a.Error)
+		return err
+	}
+	return nil
+}
+
+// run is main without the process, it returns the exit code: 0 when every
+// query was answered, 1 when a query failed and 2 for usage errors
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("shortestpath", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	graphFile := flags.String("graph", "", "graph file: .csv, .gr (DIMACS) or .dot")
+	algorithm := flags.String("algorithm", "uniform", "uniform, astar, bidirectional or k")
+	heuristic := flags.String("heuristic", "", "A* heuristic, required with astar: euclidean, manhattan, haversine or landmarks")
+	landmarks := flags.Int("landmarks", 8, "number of landmarks for the landmarks heuristic")
+	k := flags.Int("k", 3, "number of paths for the k algorithm")
+	format := flags.String("format", "text", "output format: text or json")
+	batch := flags.Bool("batch", false, "read \"from to\" queries from stdin")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	usage := func(format string, args ...interface{}) int {
+		fmt.Fprintf(stderr, format+"\n", args...)
+		flags.Usage()
+		return 2
+	}
+	if *graphFile == "" {
+		return usage("-graph is required")
+	}
+	if *format != "text" && *format != "json" {
+		return usage("unknown format %q", *format)
+	}
+	if *batch && flags.NArg() != 0 || !*batch && flags.NArg() != 2 {
+		return usage("want from and to, or -batch")
+	}
+
+	graph, err := loader.LoadFile(*graphFile)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	e, err := newEngine(graph, *algorithm, *heuristic, *k, *landmarks)
+	if err != nil {
+		return usage("%v", err)
+	}
+
+	code := 0
+	answerQuery := func(from, to string) error {
+		a := e.query(from, to)
+		if a.Error != "" {
+			code = 1
+		}
+		return write(stdout, *format, a)
+	}
+
+	if !*batch {
+		if err := answerQuery(flags.Arg(0), flags.Arg(1)); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		return code
+	}
+
+	scanner := bufio.NewScanner(stdin)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			fmt.Fprintf(stderr, "stdin: line %d: want \"from to\", got %q\n", line, text)
+			code = 1
+			continue
+		}
+		if err := answerQuery(fields[0], fields[1]); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	return code
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
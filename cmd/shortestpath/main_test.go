@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testGraph = `p sp 4 5
+a 1 2 4
+a 1 3 2
+a 3 2 1
+a 2 4 5
+a 3 4 8
+`
+
+const testCoordinates = `p aux sp co 4
+v 1 0 0
+v 2 2 0
+v 3 1 0
+v 4 4 0
+`
+
+func writeTestGraph(t *testing.T) string {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "test.co"), []byte(testCoordinates), 0o644))
+	name := filepath.Join(dir, "test.gr")
+	assert.NoError(t, os.WriteFile(name, []byte(testGraph), 0o644))
+	return name
+}
+
+func runTest(stdin string, args ...string) (int, string, string) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	code := run(args, strings.NewReader(stdin), stdout, stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func Test_Main_TestAlgorithms(t *testing.T) {
+	graph := writeTestGraph(t)
+
+	for _, algorithm := range [][]string{{"uniform"}, {"bidirectional"}, {"astar", "-heuristic", "euclidean"}, {"astar", "-heuristic", "manhattan"}} {
+		code, stdout, _ := runTest("", append([]string{"-graph", graph, "-algorithm"}, append(algorithm, "1", "4")...)...)
+		assert.Equal(t, 0, code, algorithm)
+		assert.Equal(t, "1 -> 4: cost 8: 1 3 2 4\n", stdout, algorithm)
+	}
+
+	for _, landmarks := range []string{"1", "2", "10"} {
+		code, stdout, _ := runTest("", "-graph", graph, "-algorithm", "astar", "-heuristic", "landmarks", "-landmarks", landmarks, "1", "4")
+		assert.Equal(t, 0, code, landmarks)
+		assert.Equal(t, "1 -> 4: cost 8: 1 3 2 4\n", stdout, landmarks)
+	}
+
+	code, stdout, _ := runTest("", "-graph", graph, "-algorithm", "k", "-k", "2", "1", "4")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "#1 1 -> 4: cost 8: 1 3 2 4\n#2 1 -> 4: cost 9: 1 2 4\n", stdout)
+
+	code, stdout, _ = runTest("", "-graph", graph, "4", "1")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "4 -> 1: no path\n", stdout)
+}
+
+func Test_Main_TestJSON(t *testing.T) {
+	graph := writeTestGraph(t)
+
+	code, stdout, _ := runTest("", "-graph", graph, "-format", "json", "1", "4")
+	assert.Equal(t, 0, code)
+	assert.JSONEq(t, `{"from": "1", "to": "4", "found": true, "paths": [{"cost": 8, "path": ["1", "3", "2", "4"]}]}`, stdout)
+
+	code, stdout, _ = runTest("", "-graph", graph, "-format", "json", "1", "9")
+	assert.Equal(t, 1, code)
+	assert.JSONEq(t, `{"from": "1", "to": "9", "found": false, "paths": [], "error": "unknown vertex \"9\""}`, stdout)
+}
+
+func Test_Main_TestBatch(t *testing.T) {
+	graph := writeTestGraph(t)
+
+	code, stdout, stderr := runTest("# queries\n1 4\n\n3 2\n1\n4 1\n", "-graph", graph, "-batch")
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "1 -> 4: cost 8: 1 3 2 4\n3 -> 2: cost 1: 3 2\n4 -> 1: no path\n", stdout)
+	assert.Equal(t, "stdin: line 5: want \"from to\", got \"1\"\n", stderr)
+
+	code, stdout, _ = runTest("1 2\n1 3\n", "-graph", graph, "-batch", "-format", "json")
+	assert.Equal(t, 0, code)
+	assert.Len(t, strings.Split(strings.TrimSpace(stdout), "\n"), 2)
+}
+
+func Test_Main_TestUsage(t *testing.T) {
+	graph := writeTestGraph(t)
+
+	for _, args := range [][]string{
+		{"1", "4"},
+		{"-graph", graph, "1"},
+		{"-graph", graph, "-batch", "1", "4"},
+		{"-graph", graph, "-format", "xml", "1", "4"},
+		{"-graph", graph, "-algorithm", "dijkstra", "1", "4"},
+		{"-graph", graph, "-algorithm", "k", "-k", "0", "1", "4"},
+		{"-graph", graph, "-algorithm", "astar", "1", "4"},
+		{"-graph", graph, "-algorithm", "astar", "-heuristic", "straight", "1", "4"},
+		{"-graph", graph, "-algorithm", "astar", "-heuristic", "landmarks", "-landmarks", "0", "1", "4"},
+		{"-unknown"},
+	} {
+		code, _, _ := runTest("", args...)
+		assert.Equal(t, 2, code, args)
+	}
+
+	code, _, stderr := runTest("", "-graph", filepath.Join(t.TempDir(), "missing.gr"), "1", "4")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "missing.gr")
+}
+
+func Test_Main_TestHeuristicUnits(t *testing.T) {
+	graph := writeTestGraph(t)
+
+	// the coordinates are degrees, a metre is far less than a degree
+	code, stdout, stderr := runTest("", "-graph", graph, "-algorithm", "astar", "-heuristic", "haversine", "1", "4")
+	assert.Equal(t, 2, code)
+	assert.Empty(t, stdout)
+	assert.Contains(t, stderr, "haversine heuristic does not fit the edge costs: heuristic overestimates 1 -> 2")
+
+	// coordinates scaled past the costs would make A* miss the cheapest path
+	dir := filepath.Dir(graph)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "test.co"), []byte("p aux sp co 4\nv 1 0 0\nv 2 20 0\nv 3 10 0\nv 4 40 0\n"), 0o644))
+	code, _, stderr = runTest("", "-graph", graph, "-algorithm", "astar", "-heuristic", "euclidean", "1", "4")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "euclidean heuristic does not fit the edge costs: heuristic overestimates 1 -> 2: estimate 20 > cost 4")
+
+	// 4.5 is truncated to 4, it must not pass for an edge of cost 4
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "test.co"), []byte("p aux sp co 4\nv 1 0 0\nv 2 4.5 0\nv 3 2 0\nv 4 9 0\n"), 0o644))
+	code, _, stderr = runTest("", "-graph", graph, "-algorithm", "astar", "-heuristic", "euclidean", "1", "4")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "euclidean heuristic does not fit the edge costs: heuristic overestimates 1 -> 2: estimate 4.5 > cost 4")
+}
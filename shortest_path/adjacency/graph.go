@@ -0,0 +1,220 @@
+// Package adjacency is a mutable adjacency list graph whose vertices and
+// edges implement shortest_path.Vertex and shortest_path.Edge
+package adjacency
+
+import (
+	"fmt"
+
+	"fatdes/go_algo/shortest_path"
+)
+
+// Graph of vertices keyed by ID. It is not safe to change a graph while it is searched
+type Graph[ID comparable] struct {
+	vertices map[ID]*Vertex[ID]
+	// order of the vertices by insertion
+	order []*Vertex[ID]
+}
+
+// Vertex implements shortest_path.InVertex, so bidirectional searches can use it
+type Vertex[ID comparable] struct {
+	id  ID
+	out []shortest_path.Edge
+	in  []shortest_path.Edge
+}
+
+// Edge implements shortest_path.Edge with attributes
+type Edge[ID comparable] struct {
+	from, to   *Vertex[ID]
+	cost       int
+	attributes map[string]interface{}
+}
+
+// New creates an empty graph
+func New[ID comparable]() *Graph[ID] {
+	return &Graph[ID]{
+		vertices: map[ID]*Vertex[ID]{},
+	}
+}
+
+// AddVertex returns the vertex of id, adding it if it is new
+func (g *Graph[ID]) AddVertex(id ID) *Vertex[ID] {
+	if v, found := g.vertices[id]; found {
+		return v
+	}
+
+	v := &Vertex[ID]{id: id}
+	g.vertices[id] = v
+	g.order = append(g.order, v)
+	return v
+}
+
+// Vertex returns the vertex of id
+func (g *Graph[ID]) Vertex(id ID) (*Vertex[ID], bool) {
+	v, found := g.vertices[id]
+	return v, found
+}
+
+// Vertices returns every vertex in the order they were added
+func (g *Graph[ID]) Vertices() []*Vertex[ID] {
+	return append([]*Vertex[ID]{}, g.order...)
+}
+
+// RemoveVertex removes the vertex of id and its edges, it returns false if there is no such vertex
+func (g *Graph[ID]) RemoveVertex(id ID) bool {
+	v, found := g.vertices[id]
+	if !found {
+		return false
+	}
+
+	for _, edge := range v.out {
+		edge := edge.(*Edge[ID])
+		edge.to.in = without(edge.to.in, edge)
+	}
+	for _, edge := range v.in {
+		edge := edge.(*Edge[ID])
+		edge.from.out = without(edge.from.out, edge)
+	}
+
+	delete(g.vertices, id)
+	for i, o := range g.order {
+		if o == v {
+			g.order = append(g.order[:i], g.order[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// AddEdge adds an edge from -> to, adding the vertices if they are new.
+// Parallel edges are allowed
+func (g *Graph[ID]) AddEdge(from, to ID, cost int) *Edge[ID] {
+	edge := &Edge[ID]{
+		from: g.AddVertex(from),
+		to:   g.AddVertex(to),
+		cost: cost,
+	}
+	edge.from.out = append(edge.from.out, edge)
+	edge.to.in = append(edge.to.in, edge)
+	return edge
+}
+
+// AddUndirectedEdge adds an edge each way between a and b
+func (g *Graph[ID]) AddUndirectedEdge(a, b ID, cost int) (*Edge[ID], *Edge[ID]) {
+	return g.AddEdge(a, b, cost), g.AddEdge(b, a, cost)
+}
+
+// RemoveEdge removes edge, it returns false if edge is not in the graph
+func (g *Graph[ID]) RemoveEdge(edge *Edge[ID]) bool {
+	if v, found := g.vertices[edge.from.id]; !found || v != edge.from {
+		return false
+	}
+
+	out := without(edge.from.out, edge)
+	if len(out) == len(edge.from.out) {
+		return false
+	}
+	edge.from.out = out
+	edge.to.in = without(edge.to.in, edge)
+	return true
+}
+
+// RemoveEdges removes every edge from -> to and returns how many were removed
+func (g *Graph[ID]) RemoveEdges(from, to ID) int {
+	v, found := g.vertices[from]
+	if !found {
+		return 0
+	}
+
+	removed := 0
+	for _, edge := range append([]shortest_path.Edge{}, v.out...) {
+		if edge := edge.(*Edge[ID]); edge.to.id == to {
+			g.RemoveEdge(edge)
+			removed++
+		}
+	}
+	return removed
+}
+
+// RemoveUndirectedEdge removes every edge between a and b, both ways
+func (g *Graph[ID]) RemoveUndirectedEdge(a, b ID) int {
+	removed := g.RemoveEdges(a, b)
+	if a != b {
+		removed += g.RemoveEdges(b, a)
+	}
+	return removed
+}
+
+// without returns edges without edge, keeping the order
+func without(edges []shortest_path.Edge, edge shortest_path.Edge) []shortest_path.Edge {
+	for i, e := range edges {
+		if e == edge {
+			return append(edges[:i:i], edges[i+1:]...)
+		}
+	}
+	return edges
+}
+
+// ID of the vertex
+func (v *Vertex[ID]) ID() ID {
+	return v.id
+}
+
+// Edges leaving the vertex, the slice must not be modified
+func (v *Vertex[ID]) Edges() []shortest_path.Edge {
+	return v.out
+}
+
+// InEdges ending at the vertex, the slice must not be modified
+func (v *Vertex[ID]) InEdges() []shortest_path.Edge {
+	return v.in
+}
+
+func (v *Vertex[ID]) String() string {
+	return fmt.Sprintf("%v", v.id)
+}
+
+func (e *Edge[ID]) Cost() int {
+	return e.cost
+}
+
+// SetCost changes the cost of the edge
+func (e *Edge[ID]) SetCost(cost int) {
+	e.cost = cost
+}
+
+// FromID is the ID of the start of the edge
+func (e *Edge[ID]) FromID() ID {
+	return e.from.id
+}
+
+// ToID is the ID of the end of the edge
+func (e *Edge[ID]) ToID() ID {
+	return e.to.id
+}
+
+func (e *Edge[ID]) From() shortest_path.Vertex {
+	return e.from
+}
+
+func (e *Edge[ID]) To() shortest_path.Vertex {
+	return e.to
+}
+
+// Attribute returns the value of the attribute name
+func (e *Edge[ID]) Attribute(name string) (interface{}, bool) {
+	value, found := e.attributes[name]
+	return value, found
+}
+
+// SetAttribute sets the attribute name to value and returns the edge
+func (e *Edge[ID]) SetAttribute(name string, value interface{}) *Edge[ID] {
+	if e.attributes == nil {
+		e.attributes = map[string]interface{}{}
+	}
+	e.attributes[name] = value
+	return e
+}
+
+func (e *Edge[ID]) String() string {
+	return fmt.Sprintf("%v -> %v", e.from.id, e.to.id)
+}
@@ -0,0 +1,150 @@
+package adjacency_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/shortest_path/adjacency"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ shortest_path.InVertex = &adjacency.Vertex[string]{}
+var _ shortest_path.Edge = &adjacency.Edge[string]{}
+
+func newTestGraph() *adjacency.Graph[string] {
+	g := adjacency.New[string]()
+	g.AddEdge("a", "d", 3)
+	g.AddEdge("a", "b", 5)
+	g.AddEdge("b", "c", 1)
+	g.AddEdge("c", "e", 6)
+	g.AddEdge("c", "g", 8)
+	g.AddEdge("d", "e", 2)
+	g.AddEdge("d", "f", 2)
+	g.AddEdge("e", "b", 4)
+	g.AddEdge("f", "g", 3)
+	g.AddEdge("g", "e", 4)
+	return g
+}
+
+func ids(vertices []interface{}) []string {
+	result := make([]string, len(vertices))
+	for i, v := range vertices {
+		result[i] = v.(*adjacency.Vertex[string]).ID()
+	}
+	return result
+}
+
+func Test_Graph_TestVertices(t *testing.T) {
+	g := newTestGraph()
+
+	a, found := g.Vertex("a")
+	assert.True(t, found)
+	assert.Equal(t, "a", a.ID())
+	assert.Same(t, a, g.AddVertex("a"))
+	assert.Len(t, a.Edges(), 2)
+	assert.Empty(t, a.InEdges())
+
+	_, found = g.Vertex("h")
+	assert.False(t, found)
+
+	order := []string{}
+	for _, v := range g.Vertices() {
+		order = append(order, v.ID())
+	}
+	assert.Equal(t, []string{"a", "d", "b", "c", "e", "g", "f"}, order)
+}
+
+func Test_Graph_TestSearch(t *testing.T) {
+	g := newTestGraph()
+	a, _ := g.Vertex("a")
+	gv, _ := g.Vertex("g")
+
+	for _, uc := range []shortest_path.UniformCost{
+		shortest_path.NewUniformCostByInterface(),
+		shortest_path.NewBidirectionalByInterface(),
+	} {
+		actual := uc.Find(a, gv)
+		assert.True(t, actual.Found)
+		assert.Equal(t, 8, actual.Cost)
+		assert.Equal(t, []string{"a", "d", "f", "g"}, ids(actual.Path))
+		assert.Equal(t, "f -> g", actual.Edges[2].(*adjacency.Edge[string]).String())
+	}
+
+	path := shortest_path.NewUniformCost(shortest_path.NewInterfaceGraph()).Find(a, gv)
+	assert.Equal(t, 8, path.Cost)
+}
+
+func Test_Graph_TestRemoveEdge(t *testing.T) {
+	g := newTestGraph()
+	a, _ := g.Vertex("a")
+	gv, _ := g.Vertex("g")
+	uc := shortest_path.NewBidirectionalByInterface()
+
+	f, _ := g.Vertex("f")
+	edge := f.Edges()[0].(*adjacency.Edge[string])
+	assert.Equal(t, "g", edge.ToID())
+	assert.True(t, g.RemoveEdge(edge))
+	assert.False(t, g.RemoveEdge(edge))
+	assert.Len(t, gv.InEdges(), 1)
+
+	actual := uc.Find(a, gv)
+	assert.Equal(t, 14, actual.Cost)
+	assert.Equal(t, []string{"a", "b", "c", "g"}, ids(actual.Path))
+
+	g.AddEdge("c", "g", 1)
+	assert.Equal(t, 2, g.RemoveEdges("c", "g"))
+	assert.Equal(t, 0, g.RemoveEdges("c", "g"))
+	assert.Equal(t, 0, g.RemoveEdges("h", "g"))
+	assert.False(t, uc.Find(a, gv).Found)
+}
+
+func Test_Graph_TestRemoveVertex(t *testing.T) {
+	g := newTestGraph()
+	a, _ := g.Vertex("a")
+	gv, _ := g.Vertex("g")
+
+	assert.True(t, g.RemoveVertex("f"))
+	assert.False(t, g.RemoveVertex("f"))
+	assert.Len(t, g.Vertices(), 6)
+	d, _ := g.Vertex("d")
+	assert.Len(t, d.Edges(), 1)
+	assert.Len(t, gv.InEdges(), 1)
+
+	actual := shortest_path.NewUniformCostByInterface().Find(a, gv)
+	assert.Equal(t, 14, actual.Cost)
+}
+
+func Test_Graph_TestUndirected(t *testing.T) {
+	g := adjacency.New[int]()
+	there, back := g.AddUndirectedEdge(1, 2, 4)
+	g.AddUndirectedEdge(2, 3, 1)
+	assert.Equal(t, 1, there.FromID())
+	assert.Equal(t, 1, back.ToID())
+
+	one, _ := g.Vertex(1)
+	three, _ := g.Vertex(3)
+	uc := shortest_path.NewUniformCostByInterface()
+	assert.Equal(t, 5, uc.Find(three, one).Cost)
+
+	assert.Equal(t, 2, g.RemoveUndirectedEdge(2, 1))
+	assert.False(t, uc.Find(three, one).Found)
+}
+
+func Test_Graph_TestAttributes(t *testing.T) {
+	g := adjacency.New[string]()
+	edge := g.AddEdge("a", "b", 2).SetAttribute("name", "high street").SetAttribute("lanes", 2)
+
+	name, found := edge.Attribute("name")
+	assert.True(t, found)
+	assert.Equal(t, "high street", name)
+	_, found = edge.Attribute("toll")
+	assert.False(t, found)
+
+	edge.SetCost(7)
+	a, _ := g.Vertex("a")
+	b, _ := g.Vertex("b")
+	actual := shortest_path.NewUniformCostByInterface().Find(a, b)
+	assert.Equal(t, 7, actual.Cost)
+	lanes, _ := actual.Edges[0].(*adjacency.Edge[string]).Attribute("lanes")
+	assert.Equal(t, 2, lanes)
+}
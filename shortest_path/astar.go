@@ -0,0 +1,26 @@
+package shortest_path
+
+// Heuristic estimates the cost from vertex to goal, it must never overestimate
+type Heuristic[V comparable, C any] func(vertex, goal V) C
+
+// NewAStarByFunc searches like NewUniformCostByFunc but orders the queue by
+// cost so far plus the heuristic estimate to the goal
+func NewAStarByFunc(edges edges, edgeEnd edgeEnd, edgeCost edgeCost, heuristic Heuristic[interface{}, int]) *byFunc {
+	return &byFunc{
+		search: NewAStar(NewFuncGraph[interface{}, interface{}, int](edges, edgeEnd, edgeCost), heuristic),
+	}
+}
+
+// NewAStarByInterface searches like NewUniformCostByInterface but orders the
+// queue by cost so far plus the heuristic estimate to the goal
+func NewAStarByInterface(heuristic Heuristic[interface{}, int]) *byInterface {
+	if heuristic == nil {
+		panic("heuristic must not be nil")
+	}
+
+	return &byInterface{
+		search: NewAStar(NewInterfaceGraph(), func(vertex, goal Vertex) int {
+			return heuristic(vertex, goal)
+		}),
+	}
+}
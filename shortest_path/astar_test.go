@@ -0,0 +1,125 @@
+package shortest_path_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testGridGraph struct {
+	width, height int
+	expanded      int
+}
+
+func (graph *testGridGraph) getEdges(from interface{}) []interface{} {
+	graph.expanded++
+
+	var x, y int
+	fmt.Sscanf(from.(string), "%d,%d", &x, &y)
+
+	edges := []interface{}{}
+	for _, d := range [][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+		nx, ny := x+d[0], y+d[1]
+		if nx >= 0 && nx < graph.width && ny >= 0 && ny < graph.height {
+			edges = append(edges, fmt.Sprintf("%d,%d", nx, ny))
+		}
+	}
+	return edges
+}
+
+func (graph *testGridGraph) getEdgeEnd(edge interface{}) interface{} {
+	return edge
+}
+
+func (graph *testGridGraph) getEdgeCost(edge interface{}) int {
+	return 1
+}
+
+func testGridPosition(vertex interface{}) (float64, float64) {
+	var x, y float64
+	fmt.Sscanf(vertex.(string), "%f,%f", &x, &y)
+	return x, y
+}
+
+func Test_AStarByFunc_TestZeroHeuristicMatchesUniformCost(t *testing.T) {
+	graph := &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+	graph.buildTestByFuncGraph()
+
+	astar := shortest_path.NewAStarByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost, func(vertex, goal interface{}) int {
+		return 0
+	})
+
+	actual := astar.Find("a", "g")
+	assert.True(t, actual.Found)
+	assert.Equal(t, actual.Cost, 8)
+	assert.Equal(t, ByFuncString(actual.Path), "a,d,f,g")
+
+	actual = astar.Find("a", "h")
+	assert.False(t, actual.Found)
+}
+
+func Test_AStarByFunc_TestExpandsFewerVertices(t *testing.T) {
+	ucGraph := &testGridGraph{width: 10, height: 10}
+	uc := shortest_path.NewUniformCostByFunc(ucGraph.getEdges, ucGraph.getEdgeEnd, ucGraph.getEdgeCost)
+
+	astarGraph := &testGridGraph{width: 10, height: 10}
+	astar := shortest_path.NewAStarByFunc(astarGraph.getEdges, astarGraph.getEdgeEnd, astarGraph.getEdgeCost,
+		shortest_path.Manhattan[int](testGridPosition))
+
+	expected := uc.Find("0,0", "9,0")
+	actual := astar.Find("0,0", "9,0")
+	assert.True(t, actual.Found)
+	assert.Equal(t, expected.Cost, actual.Cost)
+	assert.Equal(t, 9, actual.Cost)
+	assert.Less(t, astarGraph.expanded, ucGraph.expanded)
+}
+
+func Test_AStarByInterface_TestShortestPathFound(t *testing.T) {
+	graph := &testByInterfaceGraph{}
+	graph.buildTestByInterfaceGraph()
+
+	astar := shortest_path.NewAStarByInterface(func(vertex, goal interface{}) int {
+		return 0
+	})
+
+	actual := astar.Find(graph.vs["a"], graph.vs["g"])
+	assert.True(t, actual.Found)
+	assert.Equal(t, actual.Cost, 8)
+	assert.Equal(t, ByInterfaceString(actual.Path), "a,d,f,g")
+}
+
+func Test_AStar_TestNilHeuristic(t *testing.T) {
+	assert.Panics(t, func() {
+		shortest_path.NewAStarByInterface(nil)
+	})
+	assert.Panics(t, func() {
+		shortest_path.NewAStarByFunc(nil, nil, nil, nil)
+	})
+	assert.Panics(t, func() {
+		shortest_path.NewAStar[string, *testTypedEdge](newTestTypedGraph(), nil)
+	})
+}
+
+func Test_AStar_TestInconsistentHeuristic(t *testing.T) {
+	graph := &testTypedGraph{edges: map[string][]*testTypedEdge{}}
+	graph.addEdge("S", "A", 1).addEdge("S", "B", 1)
+	graph.addEdge("A", "C", 1)
+	graph.addEdge("B", "C", 2)
+	graph.addEdge("C", "G", 3)
+	// admissible, but h(A) > c(A, C) + h(C)
+	estimates := map[string]int{"A": 4, "B": 1, "C": 1}
+	heuristic := func(vertex, goal string) int {
+		return estimates[vertex]
+	}
+
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](graph)
+	assert.Empty(t, shortest_path.CheckAdmissible(uc, heuristic, []string{"S", "A", "B", "C"}, "G"))
+
+	actual := shortest_path.NewAStar[string, *testTypedEdge](graph, heuristic).Find("S", "G")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 5, actual.Cost)
+	assert.Equal(t, []string{"S", "A", "C", "G"}, actual.Vertices)
+	assert.Equal(t, uc.Find("S", "G").Cost, actual.Cost)
+}
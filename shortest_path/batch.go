@@ -0,0 +1,260 @@
+package shortest_path
+
+import (
+	"container/list"
+	"context"
+	"runtime"
+	"sync"
+)
+
+// Query is a pair of endpoints answered by a Batch
+type Query struct {
+	From, To interface{}
+}
+
+// BatchOptions configure NewBatch
+type BatchOptions struct {
+	// Workers is the number of searches run at once, zero means GOMAXPROCS
+	Workers int
+	// CacheSize is the number of Results kept, zero disables the cache
+	CacheSize int
+	// Options limit every search, nil means no limits
+	Options *Options[int]
+}
+
+// CacheStats count the lookups in the cache of a Batch
+type CacheStats struct {
+	Hits   int
+	Misses int
+	// Entries is the number of Results cached
+	Entries int
+}
+
+// Batch answers many queries with a ContextFinder over a bounded pool of
+// goroutines. It is safe for concurrent use, as long as the graph of the
+// search is, see UniformCost
+type Batch struct {
+	search  ContextFinder
+	workers int
+	options *Options[int]
+
+	// cache is nil when disabled
+	cache *resultCache
+}
+
+// NewBatch creates a Batch over search
+func NewBatch(search ContextFinder, options BatchOptions) *Batch {
+	workers := options.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	b := &Batch{
+		search:  search,
+		workers: workers,
+		options: options.Options,
+	}
+	if options.CacheSize > 0 {
+		b.cache = newResultCache(options.CacheSize)
+	}
+	return b
+}
+
+// Find answers every query, results[i] answers queries[i]. Queries asked
+// more than once, in the batch or from the cache, share the same Result
+// which must not be modified
+func (b *Batch) Find(queries []Query) []*Result {
+	return b.FindContext(context.Background(), queries)
+}
+
+// FindContext answers queries like Find until ctx is done, the Err of the
+// Results not answered by then wraps the context error
+func (b *Batch) FindContext(ctx context.Context, queries []Query) []*Result {
+	results := make([]*Result, len(queries))
+
+	// every distinct query is searched once, first is its first index
+	first := make(map[Query]int, len(queries))
+	pending := []int{}
+	for i, query := range queries {
+		if _, found := first[query]; found {
+			continue
+		}
+		first[query] = i
+		if result, found := b.cache.get(query); found {
+			results[i] = result
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < b.workers && w < len(pending); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = b.find(ctx, queries[i])
+			}
+		}()
+	}
+
+	sent := 0
+send:
+	for _, i := range pending {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case indexes <- i:
+			sent++
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(indexes)
+	// the queries not sent are not searched
+	for _, i := range pending[sent:] {
+		results[i] = &Result{Found: false, Err: &LimitError[interface{}, interface{}, int]{Err: ctx.Err()}}
+	}
+	wg.Wait()
+
+	for i, query := range queries {
+		results[i] = results[first[query]]
+	}
+	return results
+}
+
+// find searches one query and caches the result
+func (b *Batch) find(ctx context.Context, query Query) *Result {
+	generation := b.cache.generation()
+	result := b.search.FindContext(ctx, query.From, query.To, b.options)
+	// results of searches stopped early are not the answer
+	if result.Err == nil {
+		b.cache.put(query, result, generation)
+	}
+	return result
+}
+
+// Invalidate removes the cached Result of from -> to, searches running
+// while it is called are not cached either
+func (b *Batch) Invalidate(from, to interface{}) {
+	b.cache.invalidate(&Query{From: from, To: to})
+}
+
+// InvalidateAll empties the cache, after the graph changed
+func (b *Batch) InvalidateAll() {
+	b.cache.invalidate(nil)
+}
+
+// CacheStats returns the lookups in the cache so far, zero without cache
+func (b *Batch) CacheStats() CacheStats {
+	return b.cache.stats()
+}
+
+// resultCache is a least recently used cache of Results, a nil cache is
+// empty and keeps nothing
+type resultCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[Query]*list.Element
+	// order holds the queries, most recently used first
+	order *list.List
+	// current is increased by every invalidation, results of searches
+	// started before are dropped
+	current int
+	hits    int
+	misses  int
+}
+
+type cacheEntry struct {
+	query  Query
+	result *Result
+}
+
+func newResultCache(size int) *resultCache {
+	return &resultCache{
+		size:    size,
+		entries: map[Query]*list.Element{},
+		order:   list.New(),
+	}
+}
+
+func (c *resultCache) get(query Query) (*Result, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, found := c.entries[query]
+	if !found {
+		c.misses++
+		return nil, false
+	}
+	c.hits++
+	c.order.MoveToFront(element)
+	return element.Value.(*cacheEntry).result, true
+}
+
+func (c *resultCache) generation() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.current
+}
+
+// put caches result unless the cache was invalidated since generation
+func (c *resultCache) put(query Query, result *Result, generation int) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.current {
+		return
+	}
+	if element, found := c.entries[query]; found {
+		element.Value.(*cacheEntry).result = result
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[query] = c.order.PushFront(&cacheEntry{query: query, result: result})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).query)
+	}
+}
+
+// invalidate removes query, or everything if query is nil
+func (c *resultCache) invalidate(query *Query) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.current++
+	if query == nil {
+		c.entries = map[Query]*list.Element{}
+		c.order.Init()
+		return
+	}
+	if element, found := c.entries[*query]; found {
+		c.order.Remove(element)
+		delete(c.entries, *query)
+	}
+}
+
+func (c *resultCache) stats() CacheStats {
+	if c == nil {
+		return CacheStats{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheStats{Hits: c.hits, Misses: c.misses, Entries: c.order.Len()}
+}
@@ -0,0 +1,174 @@
+package shortest_path_test
+
+import (
+	"context"
+	"errors"
+	"fatdes/go_algo/shortest_path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testCountingSearch counts the searches running at once
+type testCountingSearch struct {
+	shortest_path.ContextFinder
+
+	mu            sync.Mutex
+	running, peak int
+	searches      int
+}
+
+func (s *testCountingSearch) FindContext(ctx context.Context, from, to interface{}, options *shortest_path.Options[int]) *shortest_path.Result {
+	s.mu.Lock()
+	s.running++
+	s.searches++
+	if s.running > s.peak {
+		s.peak = s.running
+	}
+	s.mu.Unlock()
+
+	time.Sleep(time.Millisecond)
+	result := s.ContextFinder.FindContext(ctx, from, to, options)
+
+	s.mu.Lock()
+	s.running--
+	s.mu.Unlock()
+	return result
+}
+
+func newTestBatchSearch() *testCountingSearch {
+	graph := &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+	graph.buildTestByFuncGraph()
+	return &testCountingSearch{ContextFinder: shortest_path.NewUniformCostByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost)}
+}
+
+func testBatchQueries() []shortest_path.Query {
+	vertices := []interface{}{"a", "b", "c", "d", "e", "f", "g", "h"}
+	queries := []shortest_path.Query{}
+	for _, from := range vertices {
+		for _, to := range vertices {
+			queries = append(queries, shortest_path.Query{From: from, To: to})
+		}
+	}
+	return queries
+}
+
+func Test_Batch_TestInputOrder(t *testing.T) {
+	search := newTestBatchSearch()
+	batch := shortest_path.NewBatch(search, shortest_path.BatchOptions{Workers: 3})
+
+	queries := testBatchQueries()
+	results := batch.Find(queries)
+	assert.Len(t, results, len(queries))
+	for i, query := range queries {
+		expected := search.ContextFinder.FindContext(context.Background(), query.From, query.To, nil)
+		assert.Equal(t, expected.Found, results[i].Found, query)
+		assert.Equal(t, expected.Cost, results[i].Cost, query)
+		assert.Equal(t, expected.Path, results[i].Path, query)
+	}
+
+	assert.LessOrEqual(t, search.peak, 3)
+	assert.Greater(t, search.peak, 1)
+	assert.Equal(t, len(queries), search.searches)
+	assert.Equal(t, shortest_path.CacheStats{}, batch.CacheStats())
+}
+
+func Test_Batch_TestDuplicates(t *testing.T) {
+	search := newTestBatchSearch()
+	batch := shortest_path.NewBatch(search, shortest_path.BatchOptions{})
+
+	query := shortest_path.Query{From: "a", To: "g"}
+	results := batch.Find([]shortest_path.Query{query, {From: "b", To: "e"}, query})
+	assert.Equal(t, 2, search.searches)
+	assert.Equal(t, 8, results[0].Cost)
+	assert.Equal(t, 7, results[1].Cost)
+	assert.Same(t, results[0], results[2])
+
+	assert.Empty(t, batch.Find(nil))
+}
+
+func Test_Batch_TestCache(t *testing.T) {
+	search := newTestBatchSearch()
+	batch := shortest_path.NewBatch(search, shortest_path.BatchOptions{Workers: 2, CacheSize: 2})
+	ag := shortest_path.Query{From: "a", To: "g"}
+	be := shortest_path.Query{From: "b", To: "e"}
+	cg := shortest_path.Query{From: "c", To: "g"}
+
+	first := batch.Find([]shortest_path.Query{ag, be})
+	second := batch.Find([]shortest_path.Query{be, ag})
+	assert.Equal(t, 2, search.searches)
+	assert.Same(t, first[0], second[1])
+	assert.Same(t, first[1], second[0])
+	assert.Equal(t, shortest_path.CacheStats{Hits: 2, Misses: 2, Entries: 2}, batch.CacheStats())
+
+	// a -> g was used least recently and makes room for c -> g
+	batch.Find([]shortest_path.Query{cg})
+	batch.Find([]shortest_path.Query{ag, be})
+	assert.Equal(t, 4, search.searches)
+
+	batch.Invalidate("a", "g")
+	batch.Find([]shortest_path.Query{ag})
+	assert.Equal(t, 5, search.searches)
+
+	batch.InvalidateAll()
+	assert.Equal(t, 0, batch.CacheStats().Entries)
+	batch.Find([]shortest_path.Query{ag, be})
+	assert.Equal(t, 7, search.searches)
+}
+
+func Test_Batch_TestErrorsNotCached(t *testing.T) {
+	search := newTestBatchSearch()
+	batch := shortest_path.NewBatch(search, shortest_path.BatchOptions{CacheSize: 10, Options: &shortest_path.Options[int]{MaxExpansions: 1}})
+
+	query := shortest_path.Query{From: "a", To: "g"}
+	actual := batch.Find([]shortest_path.Query{query})[0]
+	assert.True(t, errors.Is(actual.Err, shortest_path.ErrMaxExpansions))
+	batch.Find([]shortest_path.Query{query})
+	assert.Equal(t, 2, search.searches)
+	assert.Equal(t, 0, batch.CacheStats().Entries)
+}
+
+func Test_Batch_TestContext(t *testing.T) {
+	search := newTestBatchSearch()
+	batch := shortest_path.NewBatch(search, shortest_path.BatchOptions{Workers: 2, CacheSize: 100})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results := batch.FindContext(ctx, testBatchQueries())
+	for _, result := range results {
+		assert.False(t, result.Found)
+		assert.True(t, errors.Is(result.Err, context.Canceled))
+	}
+	assert.Equal(t, 0, batch.CacheStats().Entries)
+}
+
+func Test_Batch_TestByInterfaceConcurrent(t *testing.T) {
+	graph := &testByInterfaceGraph{}
+	graph.buildTestByInterfaceGraph()
+	batch := shortest_path.NewBatch(shortest_path.NewBidirectionalByInterface(), shortest_path.BatchOptions{Workers: 4})
+
+	queries := []shortest_path.Query{}
+	for _, from := range graph.vs {
+		for _, to := range graph.vs {
+			queries = append(queries, shortest_path.Query{From: from, To: to})
+		}
+	}
+
+	// run from several goroutines at once, go test -race checks the search keeps no shared state
+	wg := sync.WaitGroup{}
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results := batch.Find(queries)
+			for i, query := range queries {
+				if query.From == graph.vs["a"] && query.To == graph.vs["g"] {
+					assert.Equal(t, 8, results[i].Cost)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,140 @@
+package shortest_path
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NegativeCycleError names the vertices of a negative cost cycle reachable
+// from the source, the first vertex is repeated at the end
+type NegativeCycleError[V comparable] struct {
+	Cycle []V
+}
+
+func (e *NegativeCycleError[V]) Error() string {
+	vertices := make([]string, len(e.Cycle))
+	for i, v := range e.Cycle {
+		vertices[i] = fmt.Sprintf("%v", v)
+	}
+	return fmt.Sprintf("negative cycle: %s", strings.Join(vertices, " -> "))
+}
+
+// NewBellmanFord creates a search that accepts negative edge costs, Find
+// reports a NegativeCycleError when a negative cycle is reachable from the source
+func NewBellmanFord[V comparable, E any, C any](graph Graph[V, E, C]) *Search[V, E, C] {
+	return &Search[V, E, C]{
+		graph:       graph,
+		costs:       costsOf[C](),
+		bellmanFord: true,
+	}
+}
+
+// NewBellmanFordByFunc searches like NewUniformCostByFunc but accepts negative edge costs
+func NewBellmanFordByFunc(edges edges, edgeEnd edgeEnd, edgeCost edgeCost) *byFunc {
+	return &byFunc{
+		search: NewBellmanFord(NewFuncGraph[interface{}, interface{}, int](edges, edgeEnd, edgeCost)),
+	}
+}
+
+// NewBellmanFordByInterface searches like NewUniformCostByInterface but accepts negative edge costs
+func NewBellmanFordByInterface() *byInterface {
+	return &byInterface{
+		search: NewBellmanFord(NewInterfaceGraph()),
+	}
+}
+
+func (s *Search[V, E, C]) findBellmanFord(from, to V, limits *limiter[V, E, C], probe *probe[V, E, C]) *Path[V, E, C] {
+	best, _, err := s.relaxAll([]V{from}, limits, probe)
+	if err != nil {
+		return &Path[V, E, C]{Found: false, Err: err}
+	}
+
+	found, ok := best[to]
+	if !ok {
+		return &Path[V, E, C]{Found: false}
+	}
+
+	return found.toPath()
+}
+
+// cycle returns end, ..., n.vertex, end if end is on the path of n, otherwise nil
+func (n *node[V, E, C]) cycle(end V) []V {
+	cycle := []V{end}
+	for current := n; current != nil; current = current.parent {
+		cycle = append(cycle, current.vertex)
+		if current.vertex == end {
+			for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+				cycle[i], cycle[j] = cycle[j], cycle[i]
+			}
+			return cycle
+		}
+	}
+	return nil
+}
+
+// relaxAll relaxes edges in FIFO order (SPFA) until no cost improves and
+// returns the cheapest node of every vertex reachable from the sources, and
+// the vertices in the order they were first reached. Labels only keep simple
+// paths, so improving a vertex already on the path of the label being
+// relaxed closes a negative cycle
+func (s *Search[V, E, C]) relaxAll(sources []V, limits *limiter[V, E, C], probe *probe[V, E, C]) (map[V]*node[V, E, C], []V, error) {
+	best := map[V]*node[V, E, C]{}
+	reached := []V{}
+	queue := []V{}
+	queued := map[V]bool{}
+	for _, from := range sources {
+		if queued[from] {
+			continue
+		}
+		best[from] = &node[V, E, C]{vertex: from}
+		reached = append(reached, from)
+		queue = append(queue, from)
+		queued[from] = true
+		probe.pushed(best[from], len(queue))
+	}
+
+	for len(queue) > 0 {
+		vertex := queue[0]
+		queue = queue[1:]
+		queued[vertex] = false
+		n := best[vertex]
+		probe.popped(n)
+
+		// costs may still decrease, so there is no lower bound to check
+		var noBound C
+		if err := limits.settle(nil, noBound, false); err != nil {
+			return nil, nil, err
+		}
+		probe.settled(n)
+
+		for _, edge := range s.graph.Edges(vertex) {
+			end := s.graph.EdgeEnd(edge)
+			totalCost, err := s.relax(n, edge, end)
+			if err != nil {
+				return nil, nil, err
+			}
+			if found, ok := best[end]; ok && !s.costs.less(totalCost, found.totalCost) {
+				probe.relaxed(n, end, edge, totalCost, false)
+				continue
+			}
+			probe.relaxed(n, end, edge, totalCost, true)
+
+			if cycle := n.cycle(end); cycle != nil {
+				return nil, nil, &NegativeCycleError[V]{Cycle: cycle}
+			}
+
+			if _, ok := best[end]; !ok {
+				reached = append(reached, end)
+			}
+			best[end] = n.extend(end, edge, totalCost)
+
+			if !queued[end] {
+				queued[end] = true
+				queue = append(queue, end)
+				probe.pushed(best[end], len(queue))
+			}
+		}
+	}
+
+	return best, reached, nil
+}
@@ -0,0 +1,117 @@
+package shortest_path_test
+
+import (
+	"errors"
+	"fatdes/go_algo/shortest_path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestNegativeGraph() *testTypedGraph {
+	graph := &testTypedGraph{edges: map[string][]*testTypedEdge{}}
+	graph.addEdge("a", "b", 4).addEdge("a", "c", 2)
+	graph.addEdge("c", "b", -3)
+	graph.addEdge("b", "d", 1)
+	// unreachable from a
+	graph.addEdge("x", "y", -1)
+	graph.addEdge("y", "x", -1)
+	return graph
+}
+
+func Test_BellmanFord_TestNegativeCost(t *testing.T) {
+	bf := shortest_path.NewBellmanFord[string, *testTypedEdge](newTestNegativeGraph())
+
+	actual := bf.Find("a", "d")
+	assert.NoError(t, actual.Err)
+	assert.True(t, actual.Found)
+	assert.Equal(t, 0, actual.Cost)
+	assert.Equal(t, []string{"a", "c", "b", "d"}, actual.Vertices)
+	assert.Len(t, actual.Edges, 3)
+
+	actual = bf.Find("a", "a")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 0, actual.Cost)
+
+	actual = bf.Find("a", "x")
+	assert.NoError(t, actual.Err)
+	assert.False(t, actual.Found)
+}
+
+func Test_BellmanFord_TestNegativeCycle(t *testing.T) {
+	graph := newTestNegativeGraph()
+	graph.addEdge("d", "c", 1)
+	bf := shortest_path.NewBellmanFord[string, *testTypedEdge](graph)
+
+	actual := bf.Find("a", "d")
+	assert.False(t, actual.Found)
+
+	var cycleErr *shortest_path.NegativeCycleError[string]
+	assert.True(t, errors.As(actual.Err, &cycleErr))
+	assert.Len(t, cycleErr.Cycle, 4)
+	assert.Equal(t, cycleErr.Cycle[0], cycleErr.Cycle[3])
+	assert.ElementsMatch(t, []string{"b", "c", "d"}, cycleErr.Cycle[:3])
+	assert.Contains(t, actual.Err.Error(), "negative cycle: ")
+
+	actual = bf.Find("x", "y")
+	assert.True(t, errors.As(actual.Err, &cycleErr))
+	assert.Equal(t, "negative cycle: x -> y -> x", actual.Err.Error())
+}
+
+func Test_BellmanFord_TestUniformCostRefusesNegativeCost(t *testing.T) {
+	graph := newTestNegativeGraph()
+
+	actual := shortest_path.NewUniformCost[string, *testTypedEdge](graph).Find("a", "d")
+	assert.False(t, actual.Found)
+	assert.True(t, errors.Is(actual.Err, shortest_path.ErrNegativeCost))
+
+	in := map[string][]*testTypedEdge{}
+	for _, edges := range graph.edges {
+		for _, edge := range edges {
+			in[edge.to] = append(in[edge.to], edge)
+		}
+	}
+	bi := shortest_path.NewBidirectional(shortest_path.NewReverseFuncGraph(graph.Edges, graph.EdgeEnd, graph.EdgeCost,
+		func(to string) []*testTypedEdge { return in[to] },
+		func(edge *testTypedEdge) string { return edge.from },
+	))
+	actual = bi.Find("a", "d")
+	assert.False(t, actual.Found)
+	assert.True(t, errors.Is(actual.Err, shortest_path.ErrNegativeCost))
+}
+
+func Test_BellmanFordByFunc_TestShortestPathFound(t *testing.T) {
+	graph := &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+	graph.buildTestByFuncGraph()
+	graph.addEdge("b", "f", -4)
+
+	uc := shortest_path.NewUniformCostByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost)
+	actual := uc.Find("a", "g")
+	assert.False(t, actual.Found)
+	assert.True(t, errors.Is(actual.Err, shortest_path.ErrNegativeCost))
+
+	bf := shortest_path.NewBellmanFordByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost)
+	actual = bf.Find("a", "g")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 4, actual.Cost)
+	assert.Equal(t, "a,b,f,g", ByFuncString(actual.Path))
+}
+
+func Test_BellmanFordByInterface_TestShortestPathFound(t *testing.T) {
+	graph := &testByInterfaceGraph{}
+	graph.buildTestByInterfaceGraph()
+	graph.vs["g"].addEdge(graph.vs["a"], -8)
+
+	bf := shortest_path.NewBellmanFordByInterface()
+
+	actual := bf.Find(graph.vs["a"], graph.vs["g"])
+	assert.True(t, actual.Found)
+	assert.Equal(t, 8, actual.Cost)
+
+	graph.vs["g"].addEdge(graph.vs["d"], -9)
+	actual = bf.Find(graph.vs["a"], graph.vs["g"])
+	assert.False(t, actual.Found)
+
+	var cycleErr *shortest_path.NegativeCycleError[shortest_path.Vertex]
+	assert.True(t, errors.As(actual.Err, &cycleErr))
+}
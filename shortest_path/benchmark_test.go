@@ -0,0 +1,81 @@
+package shortest_path_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"math/rand"
+	"testing"
+)
+
+type testBenchEdge struct {
+	from, to int
+	cost     int
+}
+
+// newTestBenchGraph is a random graph with a fixed seed, dense enough that
+// vertices are reached many times before they are settled
+func newTestBenchGraph(vertices, degree int) (out, in [][]testBenchEdge) {
+	r := rand.New(rand.NewSource(1))
+	out = make([][]testBenchEdge, vertices)
+	in = make([][]testBenchEdge, vertices)
+	for v := 0; v < vertices; v++ {
+		for i := 0; i < degree; i++ {
+			edge := testBenchEdge{from: v, to: r.Intn(vertices), cost: 1 + r.Intn(100)}
+			out[v] = append(out[v], edge)
+			in[edge.to] = append(in[edge.to], edge)
+		}
+	}
+	return out, in
+}
+
+func benchmarkSearch(b *testing.B, newSearch func(out, in [][]testBenchEdge) *shortest_path.Search[int, testBenchEdge, int]) {
+	out, in := newTestBenchGraph(5000, 20)
+	search := newSearch(out, in)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		search.Find(i%len(out), (i*7919+1)%len(out))
+	}
+}
+
+func BenchmarkUniformCost(b *testing.B) {
+	benchmarkSearch(b, func(out, in [][]testBenchEdge) *shortest_path.Search[int, testBenchEdge, int] {
+		return shortest_path.NewUniformCost(shortest_path.NewFuncGraph(
+			func(v int) []testBenchEdge { return out[v] },
+			func(edge testBenchEdge) int { return edge.to },
+			func(edge testBenchEdge) int { return edge.cost },
+		))
+	})
+}
+
+func BenchmarkBidirectional(b *testing.B) {
+	benchmarkSearch(b, func(out, in [][]testBenchEdge) *shortest_path.Search[int, testBenchEdge, int] {
+		return shortest_path.NewBidirectional(shortest_path.NewReverseFuncGraph(
+			func(v int) []testBenchEdge { return out[v] },
+			func(edge testBenchEdge) int { return edge.to },
+			func(edge testBenchEdge) int { return edge.cost },
+			func(v int) []testBenchEdge { return in[v] },
+			func(edge testBenchEdge) int { return edge.from },
+		))
+	})
+}
+
+func BenchmarkLongPath(b *testing.B) {
+	const length = 10000
+	uc := shortest_path.NewUniformCost(shortest_path.NewFuncGraph(
+		func(v int) []int {
+			if v == length {
+				return nil
+			}
+			return []int{v + 1}
+		},
+		func(edge int) int { return edge },
+		func(edge int) int { return 1 },
+	))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		uc.Find(0, length)
+	}
+}
@@ -0,0 +1,183 @@
+package shortest_path
+
+import (
+	"container/heap"
+)
+
+// NewBidirectional creates a uniform cost search that expands from both ends
+// at once and stops when the two frontiers meet
+func NewBidirectional[V comparable, E any, C any](graph ReverseGraph[V, E, C]) *Search[V, E, C] {
+	return &Search[V, E, C]{
+		graph:   graph,
+		costs:   costsOf[C](),
+		reverse: graph,
+	}
+}
+
+// NewBidirectionalByFunc is NewUniformCostByFunc with a ReverseByFunc of
+// inEdges and edgeStart
+func NewBidirectionalByFunc(edges edges, edgeEnd edgeEnd, edgeCost edgeCost, inEdges edges, edgeStart edgeEnd) *byFunc {
+	return NewUniformCostByFunc(edges, edgeEnd, edgeCost, ReverseByFunc{InEdges: inEdges, EdgeStart: edgeStart})
+}
+
+// NewBidirectionalByInterface is NewUniformCostByInterface, which searches
+// from both ends when the target implements InVertex
+func NewBidirectionalByInterface() *byInterface {
+	return newUniformCostByInterface()
+}
+
+// partialReverse is a ReverseGraph that knows the in-edges of some vertices only
+type partialReverse[V comparable] interface {
+	hasInEdges(vertex V) bool
+}
+
+// frontier is one direction of a bidirectional search, nodes of the backward
+// frontier link back to the target
+type frontier[V comparable, E any, C any] struct {
+	pq       PriorityQueue
+	queued   map[V]*Item
+	best     map[V]*node[V, E, C]
+	explored map[V]bool
+
+	edges func(V) []E
+	end   func(E) V
+	less  LessFunc
+}
+
+func newFrontier[V comparable, E any, C any](start V, edges func(V) []E, end func(E) V, less LessFunc) *frontier[V, E, C] {
+	initialNode := &node[V, E, C]{
+		vertex: start,
+	}
+
+	item := NewLessItem(initialNode, less)
+	f := &frontier[V, E, C]{
+		pq:       PriorityQueue{item},
+		queued:   map[V]*Item{start: item},
+		best:     map[V]*node[V, E, C]{start: initialNode},
+		explored: map[V]bool{},
+		edges:    edges,
+		end:      end,
+		less:     less,
+	}
+	heap.Init(&f.pq)
+	return f
+}
+
+func (f *frontier[V, E, C]) top() C {
+	return f.pq[0].value.(*node[V, E, C]).key
+}
+
+// findBidirectional searches from both ends, it searches forwards only if
+// the backward frontier reaches a vertex whose in-edges are unknown
+func (s *Search[V, E, C]) findBidirectional(from, to V, limits *limiter[V, E, C], probe *probe[V, E, C]) *Path[V, E, C] {
+	partial, _ := s.reverse.(partialReverse[V])
+	if partial != nil && !partial.hasInEdges(to) {
+		return s.findOneWay(from, to, limits, probe)
+	}
+
+	less := s.lessNode
+	forward := newFrontier[V, E, C](from, s.reverse.Edges, s.reverse.EdgeEnd, less)
+	probe.pushed(forward.best[from], 1)
+	backward := newFrontier[V, E, C](to, s.reverse.InEdges, s.reverse.EdgeStart, less)
+	probe.pushed(backward.best[to], 2)
+
+	// cheapest path seen so far joins meet[0] from the source with meet[1] from the target
+	var meet [2]*node[V, E, C]
+	var best C
+
+	for forward.pq.Len() > 0 && backward.pq.Len() > 0 {
+		lowerBound, err := s.costs.sum(forward.top(), backward.top())
+		if err != nil {
+			return &Path[V, E, C]{Found: false, Err: err}
+		}
+		if meet[0] != nil && !s.costs.less(lowerBound, best) {
+			break
+		}
+
+		side, other, isForward := forward, backward, true
+		if s.costs.less(backward.top(), forward.top()) {
+			side, other, isForward = backward, forward, false
+		}
+
+		n := heap.Pop(&side.pq).(*Item).value.(*node[V, E, C])
+		if !isForward && partial != nil && !partial.hasInEdges(n.vertex) {
+			// the work so far stays in the Stats
+			return s.findOneWay(from, to, limits, probe)
+		}
+		delete(side.queued, n.vertex)
+		side.explored[n.vertex] = true
+		probe.popped(n)
+
+		// only paths of the forward frontier start at the source
+		closest := n
+		if !isForward {
+			closest = nil
+		}
+		if err := limits.settle(closest, lowerBound, true); err != nil {
+			return &Path[V, E, C]{Found: false, Err: err}
+		}
+		probe.settled(n)
+
+		for _, edge := range side.edges(n.vertex) {
+			end := side.end(edge)
+			totalCost, err := s.relax(n, edge, end)
+			if err != nil {
+				return &Path[V, E, C]{Found: false, Err: err}
+			}
+
+			if side.explored[end] {
+				probe.relaxed(n, end, edge, totalCost, false)
+				continue
+			}
+
+			if found, ok := side.best[end]; ok && !s.costs.less(totalCost, found.totalCost) {
+				probe.relaxed(n, end, edge, totalCost, false)
+				continue
+			}
+			probe.relaxed(n, end, edge, totalCost, true)
+
+			newNode := n.extend(end, edge, totalCost)
+			side.best[end] = newNode
+			if item, found := side.queued[end]; found {
+				side.pq.UpdateValue(item, newNode)
+			} else {
+				item := NewLessItem(
+					newNode,
+					side.less,
+				)
+				heap.Push(&side.pq, item)
+				side.queued[end] = item
+				probe.pushed(newNode, forward.pq.Len()+backward.pq.Len())
+			}
+
+			if o, ok := other.best[end]; ok {
+				cost, err := s.costs.sum(totalCost, o.totalCost)
+				if err != nil {
+					return &Path[V, E, C]{Found: false, Err: err}
+				}
+				if meet[0] == nil || s.costs.less(cost, best) {
+					best = cost
+					if isForward {
+						meet = [2]*node[V, E, C]{newNode, o}
+					} else {
+						meet = [2]*node[V, E, C]{o, newNode}
+					}
+				}
+			}
+		}
+	}
+
+	if meet[0] == nil {
+		return &Path[V, E, C]{Found: false}
+	}
+
+	// the backward node links from the meeting vertex to the target
+	path := meet[0].toPath()
+	for current := meet[1]; current.parent != nil; current = current.parent {
+		path.Vertices = append(path.Vertices, current.parent.vertex)
+		path.Edges = append(path.Edges, current.edge)
+	}
+	path.Cost = best
+
+	return path
+}
@@ -0,0 +1,257 @@
+package shortest_path_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (graph *testByFuncGraph) getInEdges(to interface{}) []interface{} {
+	in := []interface{}{}
+	for _, edges := range graph.edges {
+		for _, edge := range edges {
+			if graph.getEdgeEnd(edge) == to {
+				in = append(in, edge)
+			}
+		}
+	}
+	return in
+}
+
+func (graph *testByFuncGraph) getEdgeStart(edge interface{}) interface{} {
+	return strings.Split(edge.(string), "_")[0]
+}
+
+type testInVertex struct {
+	id  string
+	out []shortest_path.Edge
+	in  []shortest_path.Edge
+}
+
+func (v *testInVertex) Edges() []shortest_path.Edge {
+	return v.out
+}
+
+func (v *testInVertex) InEdges() []shortest_path.Edge {
+	return v.in
+}
+
+type testInEdge struct {
+	cost     int
+	from, to *testInVertex
+}
+
+func (e *testInEdge) Cost() int {
+	return e.cost
+}
+
+func (e *testInEdge) From() shortest_path.Vertex {
+	return e.from
+}
+
+func (e *testInEdge) To() shortest_path.Vertex {
+	return e.to
+}
+
+func (v *testInVertex) addEdge(to *testInVertex, cost int) *testInVertex {
+	edge := &testInEdge{cost: cost, from: v, to: to}
+	v.out = append(v.out, edge)
+	to.in = append(to.in, edge)
+	return v
+}
+
+// testOutVertex does not know its in-edges
+type testOutVertex struct {
+	id  string
+	out []shortest_path.Edge
+}
+
+func (v *testOutVertex) Edges() []shortest_path.Edge {
+	return v.out
+}
+
+// testMixedEdge joins testInVertex and testOutVertex
+type testMixedEdge struct {
+	cost     int
+	from, to shortest_path.Vertex
+}
+
+func (e *testMixedEdge) Cost() int {
+	return e.cost
+}
+
+func (e *testMixedEdge) From() shortest_path.Vertex {
+	return e.from
+}
+
+func (e *testMixedEdge) To() shortest_path.Vertex {
+	return e.to
+}
+
+// linkTestMixed adds an edge from -> to, from and to are *testInVertex or *testOutVertex
+func linkTestMixed(from, to shortest_path.Vertex, cost int) {
+	edge := &testMixedEdge{cost: cost, from: from, to: to}
+	switch v := from.(type) {
+	case *testInVertex:
+		v.out = append(v.out, edge)
+	case *testOutVertex:
+		v.out = append(v.out, edge)
+	}
+	if v, ok := to.(*testInVertex); ok {
+		v.in = append(v.in, edge)
+	}
+}
+
+func Test_BidirectionalByFunc_TestMatchesUniformCost(t *testing.T) {
+	graph := &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+	graph.buildTestByFuncGraph()
+
+	uc := shortest_path.NewUniformCostByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost)
+	bi := shortest_path.NewBidirectionalByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost, graph.getInEdges, graph.getEdgeStart)
+
+	vertices := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	for _, from := range vertices {
+		for _, to := range vertices {
+			expected := uc.Find(from, to)
+			actual := bi.Find(from, to)
+			assert.Equal(t, expected.Found, actual.Found, "%s -> %s", from, to)
+			assert.Equal(t, expected.Cost, actual.Cost, "%s -> %s", from, to)
+		}
+	}
+
+	actual := bi.Find("a", "g")
+	assert.Equal(t, ByFuncString(actual.Path), "a,d,f,g")
+}
+
+func Test_Bidirectional_TestTypedEdges(t *testing.T) {
+	graph := newTestTypedGraph()
+	in := map[string][]*testTypedEdge{}
+	for _, edges := range graph.edges {
+		for _, edge := range edges {
+			in[edge.to] = append(in[edge.to], edge)
+		}
+	}
+
+	bi := shortest_path.NewBidirectional(shortest_path.NewReverseFuncGraph(graph.Edges, graph.EdgeEnd, graph.EdgeCost,
+		func(to string) []*testTypedEdge { return in[to] },
+		func(edge *testTypedEdge) string { return edge.from },
+	))
+
+	actual := bi.Find("a", "g")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 8, actual.Cost)
+	assert.Equal(t, []string{"a", "d", "f", "g"}, actual.Vertices)
+	assert.Equal(t, []*testTypedEdge{graph.edges["a"][0], graph.edges["d"][1], graph.edges["f"][0]}, actual.Edges)
+}
+
+func Test_Bidirectional_TestSettlesFewerVertices(t *testing.T) {
+	ucGraph := &testGridGraph{width: 31, height: 21}
+	uc := shortest_path.NewUniformCostByFunc(ucGraph.getEdges, ucGraph.getEdgeEnd, ucGraph.getEdgeCost)
+
+	biGraph := &testGridGraph{width: 31, height: 21}
+	bi := shortest_path.NewBidirectionalByFunc(biGraph.getEdges, biGraph.getEdgeEnd, biGraph.getEdgeCost,
+		biGraph.getEdges, biGraph.getEdgeEnd)
+
+	expected := uc.Find("10,10", "20,10")
+	actual := bi.Find("10,10", "20,10")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 10, expected.Cost)
+	assert.Equal(t, expected.Cost, actual.Cost)
+	assert.Less(t, biGraph.expanded*3, ucGraph.expanded*2, fmt.Sprintf("settled %d vs %d", biGraph.expanded, ucGraph.expanded))
+}
+
+func Test_BidirectionalByInterface_TestInVertex(t *testing.T) {
+	vs := map[string]*testInVertex{}
+	for _, id := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		vs[id] = &testInVertex{id: id}
+	}
+	vs["a"].addEdge(vs["d"], 3).addEdge(vs["b"], 5)
+	vs["b"].addEdge(vs["c"], 1)
+	vs["c"].addEdge(vs["e"], 6).addEdge(vs["g"], 8)
+	vs["d"].addEdge(vs["e"], 2).addEdge(vs["f"], 2)
+	vs["e"].addEdge(vs["b"], 4)
+	vs["f"].addEdge(vs["g"], 3)
+	vs["g"].addEdge(vs["e"], 4)
+
+	bi := shortest_path.NewBidirectionalByInterface()
+
+	actual := bi.Find(vs["a"], vs["g"])
+	assert.True(t, actual.Found)
+	assert.Equal(t, 8, actual.Cost)
+	assert.Equal(t, []interface{}{vs["a"], vs["d"], vs["f"], vs["g"]}, actual.Path)
+
+	actual = bi.Find(vs["g"], vs["a"])
+	assert.False(t, actual.Found)
+}
+
+func Test_BidirectionalByInterface_TestFallbackWithoutInEdges(t *testing.T) {
+	graph := &testByInterfaceGraph{}
+	graph.buildTestByInterfaceGraph()
+
+	bi := shortest_path.NewBidirectionalByInterface()
+
+	actual := bi.Find(graph.vs["a"], graph.vs["g"])
+	assert.True(t, actual.Found)
+	assert.Equal(t, actual.Cost, 8)
+	assert.Equal(t, ByInterfaceString(actual.Path), "a,d,f,g")
+}
+
+func Test_BidirectionalByInterface_TestPartialInEdges(t *testing.T) {
+	// only the target knows its in-edges, the backward frontier stops at p
+	a, x1, x2, x3, p := &testOutVertex{id: "a"}, &testOutVertex{id: "x1"}, &testOutVertex{id: "x2"}, &testOutVertex{id: "x3"}, &testOutVertex{id: "p"}
+	d := &testInVertex{id: "d"}
+	linkTestMixed(a, x1, 1)
+	linkTestMixed(x1, x2, 1)
+	linkTestMixed(x2, x3, 1)
+	linkTestMixed(x3, p, 1)
+	linkTestMixed(p, d, 1)
+
+	for _, uc := range []shortest_path.UniformCost{shortest_path.NewUniformCostByInterface(), shortest_path.NewBidirectionalByInterface()} {
+		actual := uc.Find(a, d)
+		assert.True(t, actual.Found)
+		assert.Equal(t, 5, actual.Cost)
+		assert.Equal(t, []interface{}{a, x1, x2, x3, p, d}, actual.Path)
+	}
+
+	assert.Nil(t, shortest_path.NewReverseInterfaceGraph().InEdges(p))
+	assert.Equal(t, map[shortest_path.Vertex]bool{d: true, p: true}, shortest_path.CoReachableByInterface(d))
+}
+
+func Test_Bidirectional_TestSettledByObserver(t *testing.T) {
+	out, in := newTestBenchGraph(2000, 5)
+	edges := func(v interface{}) []interface{} {
+		edges := []interface{}{}
+		for _, edge := range out[v.(int)] {
+			edges = append(edges, edge)
+		}
+		return edges
+	}
+	inEdges := func(v interface{}) []interface{} {
+		edges := []interface{}{}
+		for _, edge := range in[v.(int)] {
+			edges = append(edges, edge)
+		}
+		return edges
+	}
+	edgeEnd := func(edge interface{}) interface{} { return edge.(testBenchEdge).to }
+	edgeStart := func(edge interface{}) interface{} { return edge.(testBenchEdge).from }
+	edgeCost := func(edge interface{}) int { return edge.(testBenchEdge).cost }
+
+	ucObserver, biObserver := &testCountingObserver{}, &testCountingObserver{}
+	uc := shortest_path.NewUniformCostByFunc(edges, edgeEnd, edgeCost).WithObserver(ucObserver)
+	bi := shortest_path.NewUniformCostByFunc(edges, edgeEnd, edgeCost, shortest_path.ReverseByFunc{InEdges: inEdges, EdgeStart: edgeStart}).WithObserver(biObserver)
+
+	for i := 0; i < 50; i++ {
+		from, to := i*37%2000, (i*7919+1)%2000
+		expected := uc.Find(from, to)
+		actual := bi.Find(from, to)
+		assert.Equal(t, expected.Found, actual.Found)
+		assert.Equal(t, expected.Cost, actual.Cost)
+	}
+	t.Logf("settled %d bidirectionally, %d by uniform cost", biObserver.settled, ucObserver.settled)
+	// roughly half or better
+	assert.Less(t, biObserver.settled*5, ucObserver.settled*3)
+}
@@ -1,24 +1,34 @@
 package shortest_path
 
 import (
-	"container/heap"
+	"context"
 )
 
 type edges func(interface{}) []interface{}
 type edgeEnd func(interface{}) interface{}
 type edgeCost func(interface{}) int
 
+// byFunc is safe for concurrent use when edges, edgeEnd and edgeCost are
 type byFunc struct {
-	edges    edges
-	edgeEnd  edgeEnd
-	edgeCost edgeCost
+	search *Search[interface{}, interface{}, int]
 }
 
-func NewUniformCostByFunc(edges edges, edgeEnd edgeEnd, edgeCost edgeCost) *byFunc {
+// ReverseByFunc walks the edges of a by-func graph backwards
+type ReverseByFunc struct {
+	InEdges   func(interface{}) []interface{}
+	EdgeStart func(interface{}) interface{}
+}
+
+// NewUniformCostByFunc is the untyped form of NewUniformCost(NewFuncGraph(...)),
+// given a ReverseByFunc it searches from both ends like NewBidirectional
+func NewUniformCostByFunc(edges edges, edgeEnd edgeEnd, edgeCost edgeCost, reverse ...ReverseByFunc) *byFunc {
+	if len(reverse) > 0 {
+		return &byFunc{
+			search: NewBidirectional(NewReverseFuncGraph[interface{}, interface{}, int](edges, edgeEnd, edgeCost, reverse[0].InEdges, reverse[0].EdgeStart)),
+		}
+	}
 	return &byFunc{
-		edges:    edges,
-		edgeEnd:  edgeEnd,
-		edgeCost: edgeCost,
+		search: NewUniformCost(NewFuncGraph[interface{}, interface{}, int](edges, edgeEnd, edgeCost)),
 	}
 }
 
@@ -27,66 +37,64 @@ func (b *byFunc) Find(from interface{}, to interface{}) *Result {
 		return &Result{Found: false}
 	}
 
-	if from == to {
-		return &Result{
-			Found: true,
-			Cost:  0,
-			Path: []interface{}{
-				from,
-			},
-		}
+	return toResult(b.search.Find(from, to))
+}
+
+// FindContext finds like Find but stops when ctx is done or options are exceeded
+func (b *byFunc) FindContext(ctx context.Context, from interface{}, to interface{}, options *Options[int]) *Result {
+	if from == nil || to == nil {
+		return &Result{Found: false}
 	}
 
-	pq := make(PriorityQueue, 1)
-	initialNode := &node{
-		vertex:    from,
-		totalCost: 0,
-		path: []interface{}{
-			from,
-		},
+	return toResult(b.search.FindContext(ctx, from, to, options))
+}
+
+// FindK returns up to k loopless paths from -> to, cheapest first
+func (b *byFunc) FindK(from interface{}, to interface{}, k int) []*Result {
+	if from == nil || to == nil {
+		return []*Result{}
 	}
-	pq[0] = NewInitialItem(
-		initialNode,
-		initialNode.cost,
-		0,
-	)
-	heap.Init(&pq)
-
-	explored := map[interface{}]bool{}
-
-	for pq.Len() > 0 {
-		item := heap.Pop(&pq).(*Item)
-		n := item.value.(*node)
-
-		if n.vertex == to {
-			return &Result{
-				Found: true,
-
-				Cost: n.totalCost,
-				Path: n.path,
-			}
-		}
 
-		explored[n.vertex] = true
-
-		for _, edge := range b.edges(n.vertex) {
-			to := b.edgeEnd(edge)
-			if _, found := explored[to]; !found {
-				path := make([]interface{}, len(n.path)+1)
-				copy(path, n.path)
-				path[len(path)-1] = to
-				newNode := &node{
-					vertex:    to,
-					totalCost: n.totalCost + b.edgeCost(edge),
-					path:      path,
-				}
-				heap.Push(&pq, NewItem(
-					newNode,
-					newNode.cost,
-				))
-			}
+	return toResults(b.search.FindK(from, to, k))
+}
+
+// FindAll returns the tree of cheapest paths from the source to every reachable vertex
+func (b *byFunc) FindAll(from interface{}) *ResultTree {
+	tree := newTree[interface{}, interface{}, int](nil)
+	if from != nil {
+		tree = b.search.FindAll(from)
+	}
+
+	return toResultTree(tree, func(vertex interface{}) *Result {
+		return toResult(tree.PathTo(vertex))
+	})
+}
+
+// FindNearest finds the cheapest path from any of the sources to any vertex
+// for which goal returns true, nil sources are ignored
+func (b *byFunc) FindNearest(sources []interface{}, goal func(vertex interface{}) bool) *Result {
+	from := make([]interface{}, 0, len(sources))
+	for _, source := range sources {
+		if source != nil {
+			from = append(from, source)
 		}
 	}
 
-	return &Result{Found: false}
+	return toResult(b.search.FindNearest(from, goal))
+}
+
+// FindConstrained finds the cheapest path from -> to within constraints
+func (b *byFunc) FindConstrained(from interface{}, to interface{}, constraints *Constraints[interface{}]) *Result {
+	if from == nil || to == nil {
+		return &Result{Found: false}
+	}
+
+	return toResult(b.search.FindConstrained(from, to, constraints))
+}
+
+// WithObserver returns a copy of the search that reports its steps to observer
+func (b *byFunc) WithObserver(observer Observer[interface{}, interface{}, int]) UniformCost {
+	return &byFunc{
+		search: b.search.WithObserver(observer),
+	}
 }
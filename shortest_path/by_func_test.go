@@ -97,6 +97,7 @@ func Test_UniformCostByFunc_TestSearchSameNode(t *testing.T) {
 	assert.True(t, actual.Found)
 	assert.Equal(t, actual.Cost, 0)
 	assert.Equal(t, ByFuncString(actual.Path), "a")
+	assert.Empty(t, actual.Edges)
 }
 
 func Test_UniformCostByFunc_TestShortestPathFound(t *testing.T) {
@@ -110,4 +111,5 @@ func Test_UniformCostByFunc_TestShortestPathFound(t *testing.T) {
 	assert.True(t, actual.Found)
 	assert.Equal(t, actual.Cost, 8)
 	assert.Equal(t, ByFuncString(actual.Path), "a,d,f,g")
+	assert.Equal(t, ByFuncString(actual.Edges), "a_d,d_f,f_g")
 }
@@ -1,7 +1,7 @@
 package shortest_path
 
 import (
-	"container/heap"
+	"context"
 )
 
 type Vertex interface {
@@ -14,78 +14,154 @@ type Edge interface {
 	To() Vertex
 }
 
+// InVertex is a Vertex that also knows the edges ending at it
+type InVertex interface {
+	Vertex
+	InEdges() []Edge
+}
+
+// byInterface is safe for concurrent use when the Edges, InEdges, Cost, From
+// and To methods of the graph are
 type byInterface struct {
+	search *Search[Vertex, Edge, int]
+
+	// bidirectional is used instead of search when the target is an InVertex
+	bidirectional *Search[Vertex, Edge, int]
 }
 
+// NewUniformCostByInterface is the untyped form of NewUniformCost(NewInterfaceGraph()).
+// It searches from both ends when the target implements InVertex, until it
+// meets a vertex that does not
 func NewUniformCostByInterface() UniformCost {
-	return &byInterface{}
+	return newUniformCostByInterface()
+}
+
+func newUniformCostByInterface() *byInterface {
+	return &byInterface{
+		search:        NewUniformCost(NewInterfaceGraph()),
+		bidirectional: NewBidirectional(NewReverseInterfaceGraph()),
+	}
+}
+
+// vertices returns from and to as Vertex, ok is false if either is not a Vertex
+func vertices(from, to interface{}) (Vertex, Vertex, bool) {
+	fromVertex, ok := from.(Vertex)
+	if !ok {
+		return nil, nil, false
+	}
+	toVertex, ok := to.(Vertex)
+	if !ok {
+		return nil, nil, false
+	}
+
+	return fromVertex, toVertex, true
+}
+
+// searchTo picks the bidirectional search when the target is an InVertex
+func (b *byInterface) searchTo(to Vertex) *Search[Vertex, Edge, int] {
+	if _, ok := to.(InVertex); ok && b.bidirectional != nil {
+		return b.bidirectional
+	}
+
+	return b.search
 }
 
+// Find returns not found if from or to is not a Vertex
 func (b *byInterface) Find(from interface{}, to interface{}) *Result {
-	if from == nil || to == nil {
+	fromVertex, toVertex, ok := vertices(from, to)
+	if !ok {
 		return &Result{Found: false}
 	}
 
-	if from == to {
-		return &Result{
-			Found: true,
-			Cost:  0,
-			Path: []interface{}{
-				from,
-			},
-		}
+	return toResult(b.searchTo(toVertex).Find(fromVertex, toVertex))
+}
+
+// FindContext finds like Find but stops when ctx is done or options are exceeded
+func (b *byInterface) FindContext(ctx context.Context, from interface{}, to interface{}, options *Options[int]) *Result {
+	fromVertex, toVertex, ok := vertices(from, to)
+	if !ok {
+		return &Result{Found: false}
 	}
 
-	pq := make(PriorityQueue, 1)
-	initialNode := &node{
-		vertex:    from.(Vertex),
-		totalCost: 0,
-		path: []interface{}{
-			from,
-		},
+	return toResult(b.searchTo(toVertex).FindContext(ctx, fromVertex, toVertex, options))
+}
+
+// FindK returns up to k loopless paths from -> to, cheapest first, it returns
+// no paths if from or to is not a Vertex
+func (b *byInterface) FindK(from interface{}, to interface{}, k int) []*Result {
+	fromVertex, toVertex, ok := vertices(from, to)
+	if !ok {
+		return []*Result{}
+	}
+
+	return toResults(b.search.FindK(fromVertex, toVertex, k))
+}
+
+// FindAll returns the tree of cheapest paths from the source to every
+// reachable vertex, the tree is empty if from is not a Vertex
+func (b *byInterface) FindAll(from interface{}) *ResultTree {
+	tree := newTree[Vertex, Edge, int](nil)
+	if fromVertex, ok := from.(Vertex); ok {
+		tree = b.search.FindAll(fromVertex)
 	}
-	pq[0] = NewInitialItem(
-		initialNode,
-		initialNode.cost,
-		0,
-	)
-	heap.Init(&pq)
-
-	explored := map[interface{}]bool{}
-
-	for pq.Len() > 0 {
-		item := heap.Pop(&pq).(*Item)
-		n := item.value.(*node)
-
-		if n.vertex == to {
-			return &Result{
-				Found: true,
-
-				Cost: n.totalCost,
-				Path: n.path,
-			}
+
+	return toResultTree(tree, func(vertex interface{}) *Result {
+		v, ok := vertex.(Vertex)
+		if !ok {
+			return &Result{Found: false}
 		}
+		return toResult(tree.PathTo(v))
+	})
+}
 
-		explored[n.vertex] = true
-
-		for _, edge := range n.vertex.(Vertex).Edges() {
-			to := edge.To()
-			if _, found := explored[to]; !found {
-				path := make([]interface{}, len(n.path)+1)
-				copy(path, n.path)
-				path[len(path)-1] = to
-				newNode := &node{
-					vertex:    to,
-					totalCost: n.totalCost + edge.Cost(),
-					path:      path,
-				}
-				heap.Push(&pq, NewItem(
-					newNode,
-					newNode.cost,
-				))
-			}
+// FindNearest finds the cheapest path from any of the sources to any vertex
+// for which goal returns true, sources that are not a Vertex are ignored
+func (b *byInterface) FindNearest(sources []interface{}, goal func(vertex interface{}) bool) *Result {
+	from := make([]Vertex, 0, len(sources))
+	for _, source := range sources {
+		if vertex, ok := source.(Vertex); ok {
+			from = append(from, vertex)
 		}
 	}
 
-	return &Result{Found: false}
+	return toResult(b.search.FindNearest(from, func(vertex Vertex) bool {
+		return goal(vertex)
+	}))
+}
+
+// FindConstrained finds the cheapest path from -> to within constraints, it
+// returns not found if from or to is not a Vertex
+func (b *byInterface) FindConstrained(from interface{}, to interface{}, constraints *Constraints[interface{}]) *Result {
+	fromVertex, toVertex, ok := vertices(from, to)
+	if !ok {
+		return &Result{Found: false}
+	}
+
+	var edgeConstraints *Constraints[Edge]
+	if constraints != nil {
+		edgeConstraints = &Constraints[Edge]{MaxHops: constraints.MaxHops}
+		for _, resource := range constraints.Resources {
+			usage := resource.Usage
+			edgeConstraints.Resources = append(edgeConstraints.Resources, Resource[Edge]{
+				Name:   resource.Name,
+				Usage:  func(edge Edge) int { return usage(edge) },
+				Budget: resource.Budget,
+			})
+		}
+	}
+
+	return toResult(b.search.FindConstrained(fromVertex, toVertex, edgeConstraints))
+}
+
+// WithObserver returns a copy of the search that reports its steps to
+// observer, vertices are reported as Vertex and edges as Edge
+func (b *byInterface) WithObserver(observer Observer[interface{}, interface{}, int]) UniformCost {
+	typed := &untypedObserver[Vertex, Edge]{observer: observer}
+	observed := &byInterface{
+		search: b.search.WithObserver(typed),
+	}
+	if b.bidirectional != nil {
+		observed.bidirectional = b.bidirectional.WithObserver(typed)
+	}
+	return observed
 }
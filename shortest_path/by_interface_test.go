@@ -136,4 +136,51 @@ func Test_UniformCostByInterface_TestShortestPathFound(t *testing.T) {
 	assert.True(t, actual.Found)
 	assert.Equal(t, actual.Cost, 8)
 	assert.Equal(t, ByInterfaceString(actual.Path), "a,d,f,g")
+	assert.Len(t, actual.Edges, 3)
+	for i, edge := range actual.Edges {
+		assert.Equal(t, actual.Path[i], edge.(shortest_path.Edge).From())
+		assert.Equal(t, actual.Path[i+1], edge.(shortest_path.Edge).To())
+	}
+}
+
+func Test_UniformCostByInterface_TestNotVertex(t *testing.T) {
+	graph := &testByInterfaceGraph{}
+	graph.buildTestByInterfaceGraph()
+
+	uc := shortest_path.NewUniformCostByInterface()
+
+	actual := uc.Find("a", graph.vs["b"])
+	assert.NotNil(t, actual)
+	assert.False(t, actual.Found)
+
+	actual = uc.Find(graph.vs["a"], "b")
+	assert.NotNil(t, actual)
+	assert.False(t, actual.Found)
+}
+
+// testFindOnly implements UniformCost outside of the package with Find alone
+type testFindOnly struct{}
+
+func (testFindOnly) Find(from, to interface{}) *shortest_path.Result {
+	return &shortest_path.Result{Found: false}
+}
+
+func Test_UniformCost_TestOptionalInterfaces(t *testing.T) {
+	var _ shortest_path.UniformCost = testFindOnly{}
+
+	graph := &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+	graph.buildTestByFuncGraph()
+	for _, uc := range []shortest_path.UniformCost{
+		shortest_path.NewUniformCostByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost),
+		shortest_path.NewUniformCostByInterface(),
+		shortest_path.NewBidirectionalByInterface(),
+	} {
+		assert.Implements(t, (*shortest_path.ContextFinder)(nil), uc)
+		assert.Implements(t, (*shortest_path.KFinder)(nil), uc)
+		assert.Implements(t, (*shortest_path.TreeFinder)(nil), uc)
+		assert.Implements(t, (*shortest_path.NearestFinder)(nil), uc)
+		assert.Implements(t, (*shortest_path.ConstrainedFinder)(nil), uc)
+		assert.Implements(t, (*shortest_path.Observable)(nil), uc)
+		assert.Implements(t, (*shortest_path.Diagnoser)(nil), uc)
+	}
 }
@@ -0,0 +1,43 @@
+package ch_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/shortest_path/ch"
+	"fatdes/go_algo/shortest_path/internal/testgraph"
+	"fatdes/go_algo/shortest_path/loader"
+	"testing"
+)
+
+const benchSize = 50
+
+func BenchmarkUniformCost(b *testing.B) {
+	graph := testgraph.Lattice(benchSize)
+	uc := shortest_path.NewUniformCost[string, *loader.Edge](graph)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		uc.Find(testgraph.QueryVertices(graph, i))
+	}
+}
+
+func BenchmarkHierarchy(b *testing.B) {
+	graph := testgraph.Lattice(benchSize)
+	h, _ := ch.FromLoader(graph)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.Find(testgraph.QueryVertices(graph, i))
+	}
+}
+
+func BenchmarkBuild(b *testing.B) {
+	graph := testgraph.Lattice(benchSize)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ch.FromLoader(graph)
+	}
+}
@@ -0,0 +1,330 @@
+// Package ch preprocesses static graphs into Contraction Hierarchies, which
+// answer point to point queries by searching only upwards in a vertex order
+package ch
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/shortest_path/csr"
+	"fatdes/go_algo/shortest_path/loader"
+)
+
+// witnessLimit is the number of vertices a witness search may settle before
+// it gives up and a shortcut is added
+const witnessLimit = 500
+
+// arc is an original edge, or a shortcut through the vertex middle made of
+// the arcs children
+type arc struct {
+	From, To int
+	Cost     int
+	// Middle is -1 for original edges
+	Middle   int
+	Children [2]int
+}
+
+// Edge of an unpacked path, an original edge of the graph
+type Edge[V comparable] struct {
+	From, To V
+	Cost     int
+}
+
+// Hierarchy is a contracted graph, it is immutable and safe for concurrent use
+type Hierarchy[V comparable] struct {
+	vertices []V
+	ids      map[V]int
+	// rank is the position of every vertex in the contraction order
+	rank []int
+	arcs []arc
+
+	// up holds the arcs from every vertex to higher ranked ones, down the
+	// arcs to every vertex from higher ranked ones
+	up   [][]int
+	down [][]int
+}
+
+// Build contracts the part of graph reachable from vertices. Edge costs must not be negative
+func Build[V comparable, E any](graph shortest_path.Graph[V, E, int], vertices []V) (*Hierarchy[V], error) {
+	frozen, err := csr.Build(graph, vertices)
+	if err != nil {
+		return nil, err
+	}
+
+	n := frozen.VertexCount()
+	h := &Hierarchy[V]{
+		vertices: make([]V, n),
+		ids:      make(map[V]int, n),
+		rank:     make([]int, n),
+	}
+	c := &contraction{
+		arcs: []arc{},
+		out:  make([]map[int]int, n),
+		in:   make([]map[int]int, n),
+	}
+	for v := 0; v < n; v++ {
+		h.vertices[v] = frozen.Vertex(v)
+		h.ids[h.vertices[v]] = v
+		c.out[v] = map[int]int{}
+		c.in[v] = map[int]int{}
+	}
+	for v := 0; v < n; v++ {
+		for _, e := range frozen.Edges(v) {
+			c.addArc(arc{From: v, To: frozen.EdgeEnd(e), Cost: frozen.EdgeCost(e), Middle: -1})
+		}
+	}
+
+	c.contractAll(h.rank)
+	if c.err != nil {
+		return nil, c.err
+	}
+	h.arcs = c.arcs
+	h.index()
+	return h, nil
+}
+
+// FromLoader contracts a loaded graph
+func FromLoader(graph *loader.Graph) (*Hierarchy[string], error) {
+	return Build[string, *loader.Edge](graph, graph.Vertices)
+}
+
+// VertexCount returns the number of vertices
+func (h *Hierarchy[V]) VertexCount() int {
+	return len(h.vertices)
+}
+
+// ShortcutCount returns the number of shortcuts added by the contraction
+func (h *Hierarchy[V]) ShortcutCount() int {
+	count := 0
+	for _, a := range h.arcs {
+		if a.Middle >= 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// index sorts the arcs into the search space of their lower ranked end
+func (h *Hierarchy[V]) index() {
+	h.up = make([][]int, len(h.vertices))
+	h.down = make([][]int, len(h.vertices))
+	for i, a := range h.arcs {
+		if h.rank[a.From] < h.rank[a.To] {
+			h.up[a.From] = append(h.up[a.From], i)
+		} else {
+			h.down[a.To] = append(h.down[a.To], i)
+		}
+	}
+}
+
+// contraction is the remaining graph while vertices are contracted
+type contraction struct {
+	arcs []arc
+	// out[v][w] and in[w][v] are the cheapest arc v -> w between remaining vertices
+	out []map[int]int
+	in  []map[int]int
+
+	// deleted counts the contracted neighbors of every vertex, level is one
+	// more than the highest level of them
+	deleted []int
+	level   []int
+
+	// err is set when a shortcut needed costs more than an int holds
+	err error
+
+	// buffers of the witness searches, reused between them
+	search  int
+	stamp   []int
+	settled []int
+	cost    []int
+	queue   shortest_path.PriorityQueue
+}
+
+// addArc keeps a if it is the cheapest between its ends, self loops are dropped
+func (c *contraction) addArc(a arc) {
+	if a.From == a.To {
+		return
+	}
+	if existing, found := c.out[a.From][a.To]; found && c.arcs[existing].Cost <= a.Cost {
+		return
+	}
+	c.arcs = append(c.arcs, a)
+	c.out[a.From][a.To] = len(c.arcs) - 1
+	c.in[a.To][a.From] = len(c.arcs) - 1
+}
+
+// shortcuts returns the shortcuts needed to contract v, and an error if one
+// costs more than an int holds
+func (c *contraction) shortcuts(v int) ([]arc, error) {
+	shortcuts := []arc{}
+	var err error
+	for u, in := range c.in[v] {
+		targets := map[int]int{}
+		limit := 0
+		for w, out := range c.out[v] {
+			if w == u {
+				continue
+			}
+			if c.arcs[in].Cost > math.MaxInt-c.arcs[out].Cost {
+				err = fmt.Errorf("%w: shortcut %d -> %d through %d", shortest_path.ErrCostOverflow, u, w, v)
+				continue
+			}
+			cost := c.arcs[in].Cost + c.arcs[out].Cost
+			targets[w] = cost
+			if cost > limit {
+				limit = cost
+			}
+		}
+		if len(targets) == 0 {
+			continue
+		}
+
+		witnessed := c.witness(u, v, targets, limit)
+		for w, out := range c.out[v] {
+			if cost, needed := targets[w]; needed && !witnessed[w] {
+				shortcuts = append(shortcuts, arc{From: u, To: w, Cost: cost, Middle: v, Children: [2]int{in, out}})
+			}
+		}
+	}
+	return shortcuts, err
+}
+
+// witness searches from u without passing v, and returns the targets reached
+// at most as expensively as through v
+func (c *contraction) witness(u, v int, targets map[int]int, limit int) map[int]bool {
+	// cost and settled are valid for the vertices stamped with this search
+	c.search++
+	c.stamp[u] = c.search
+	c.cost[u] = 0
+	pq := c.queue[:0]
+	// vertices are pushed again when a cheaper path is found, the stale items are skipped
+	push := func(vertex int) {
+		pushed := c.cost[vertex]
+		heap.Push(&pq, shortest_path.NewItem(vertex, func() int { return pushed }))
+	}
+	push(u)
+
+	witnessed := map[int]bool{}
+	for count := 0; pq.Len() > 0 && count < witnessLimit; count++ {
+		x := heap.Pop(&pq).(*shortest_path.Item).Value().(int)
+		if c.settled[x] == c.search {
+			continue
+		}
+		c.settled[x] = c.search
+		if c.cost[x] > limit {
+			break
+		}
+		if target, found := targets[x]; found {
+			witnessed[x] = c.cost[x] <= target
+			if len(witnessed) == len(targets) {
+				break
+			}
+		}
+
+		for y, a := range c.out[x] {
+			if y == v || c.settled[y] == c.search || c.cost[x] > limit-c.arcs[a].Cost {
+				continue
+			}
+			total := c.cost[x] + c.arcs[a].Cost
+			if c.stamp[y] != c.search || total < c.cost[y] {
+				c.stamp[y] = c.search
+				c.cost[y] = total
+				push(y)
+			}
+		}
+	}
+	c.queue = pq[:0]
+	return witnessed
+}
+
+// priority orders contraction by edge difference plus contracted neighbors
+func (c *contraction) priority(v int, shortcuts []arc) int {
+	return 2*(len(shortcuts)-len(c.in[v])-len(c.out[v])) + c.deleted[v] + c.level[v]
+}
+
+// contractAll contracts every vertex, least important first. Priorities
+// change as neighbors are contracted, they are recomputed for the neighbors
+// of every vertex contracted, and when a vertex is popped it is queued again
+// if it is no longer the least important
+func (c *contraction) contractAll(rank []int) {
+	n := len(c.out)
+	c.deleted = make([]int, n)
+	c.level = make([]int, n)
+	c.stamp = make([]int, n)
+	c.settled = make([]int, n)
+	c.cost = make([]int, n)
+
+	priorities := make([]int, n)
+	items := make([]*shortest_path.Item, n)
+	pq := make(shortest_path.PriorityQueue, 0, n)
+	for v := 0; v < n; v++ {
+		v := v
+		shortcuts, _ := c.shortcuts(v)
+		priorities[v] = c.priority(v, shortcuts)
+		items[v] = shortest_path.NewInitialItem(v, func() int { return priorities[v] }, v)
+		pq = append(pq, items[v])
+	}
+	heap.Init(&pq)
+
+	for next := 0; pq.Len() > 0; {
+		item := heap.Pop(&pq).(*shortest_path.Item)
+		v := item.Value().(int)
+		shortcuts, err := c.shortcuts(v)
+		priorities[v] = c.priority(v, shortcuts)
+		if pq.Len() > 0 && priorities[v] > priorities[pq[0].Value().(int)] {
+			heap.Push(&pq, item)
+			continue
+		}
+
+		rank[v] = next
+		next++
+		if err != nil && c.err == nil {
+			c.err = err
+		}
+		neighbors := c.neighbors(v)
+		c.contract(v, shortcuts)
+
+		// the neighbors lost an edge and may have gained shortcuts, they are all still queued
+		for _, u := range neighbors {
+			shortcuts, _ := c.shortcuts(u)
+			priorities[u] = c.priority(u, shortcuts)
+			pq.Fix(items[u])
+		}
+	}
+}
+
+// neighbors returns the remaining vertices adjacent to v
+func (c *contraction) neighbors(v int) []int {
+	neighbors := make([]int, 0, len(c.in[v])+len(c.out[v]))
+	for u := range c.in[v] {
+		neighbors = append(neighbors, u)
+	}
+	for w := range c.out[v] {
+		if _, found := c.in[v][w]; !found {
+			neighbors = append(neighbors, w)
+		}
+	}
+	return neighbors
+}
+
+// contract adds the shortcuts needed to remove v from the remaining graph
+func (c *contraction) contract(v int, shortcuts []arc) {
+	for _, shortcut := range shortcuts {
+		c.addArc(shortcut)
+	}
+
+	for u := range c.in[v] {
+		delete(c.out[u], v)
+		c.deleted[u]++
+		c.level[u] = max(c.level[u], c.level[v]+1)
+	}
+	for w := range c.out[v] {
+		delete(c.in[w], v)
+		c.deleted[w]++
+		c.level[w] = max(c.level[w], c.level[v]+1)
+	}
+	c.in[v] = nil
+	c.out[v] = nil
+}
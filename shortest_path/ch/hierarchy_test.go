@@ -0,0 +1,72 @@
+package ch_test
+
+import (
+	"errors"
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/shortest_path/adjacency"
+	"fatdes/go_algo/shortest_path/ch"
+	"fatdes/go_algo/shortest_path/internal/testgraph"
+	"fatdes/go_algo/shortest_path/loader"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Hierarchy_TestBuild(t *testing.T) {
+	h, err := ch.FromLoader(testgraph.Small())
+	assert.NoError(t, err)
+	assert.Equal(t, 8, h.VertexCount())
+	assert.GreaterOrEqual(t, h.ShortcutCount(), 0)
+}
+
+func Test_Hierarchy_TestBuildPath(t *testing.T) {
+	// contracting the middle of a path needs a shortcut around it
+	graph := loader.NewGraph()
+	for i := 0; i < 10; i++ {
+		graph.AddEdge(strconv.Itoa(i), strconv.Itoa(i+1), 1)
+	}
+
+	h, err := ch.FromLoader(graph)
+	assert.NoError(t, err)
+	assert.Equal(t, 11, h.VertexCount())
+	assert.Greater(t, h.ShortcutCount(), 0)
+}
+
+func Test_Hierarchy_TestBuildNegativeCost(t *testing.T) {
+	graph := loader.NewGraph()
+	graph.AddEdge("a", "b", -1)
+
+	_, err := ch.FromLoader(graph)
+	assert.True(t, errors.Is(err, shortest_path.ErrNegativeCost))
+}
+
+func Test_Hierarchy_TestBuildOverflow(t *testing.T) {
+	graph := loader.NewGraph()
+	graph.AddEdge("a", "b", math.MaxInt)
+	graph.AddEdge("b", "c", 1)
+	graph.AddEdge("c", "b", 1)
+	graph.AddEdge("b", "a", math.MaxInt)
+
+	_, err := ch.FromLoader(graph)
+	assert.True(t, errors.Is(err, shortest_path.ErrCostOverflow))
+}
+
+func Test_Hierarchy_TestBuildInterface(t *testing.T) {
+	g := adjacency.New[string]()
+	g.AddEdge("a", "b", 2)
+	g.AddEdge("a", "c", 9)
+	g.AddEdge("b", "c", 3)
+	a, _ := g.Vertex("a")
+	b, _ := g.Vertex("b")
+	c, _ := g.Vertex("c")
+
+	h, err := ch.Build(shortest_path.NewInterfaceGraph(), []shortest_path.Vertex{a})
+	assert.NoError(t, err)
+
+	actual := h.Find(a, c)
+	assert.True(t, actual.Found)
+	assert.Equal(t, 5, actual.Cost)
+	assert.Equal(t, []shortest_path.Vertex{a, b, c}, actual.Vertices)
+}
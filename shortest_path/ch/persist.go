@@ -0,0 +1,65 @@
+package ch
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+)
+
+// formatVersion is increased when the saved form changes
+const formatVersion = 1
+
+// saved is the form a Hierarchy is encoded in, up and down are indexed again when loaded
+type saved[V comparable] struct {
+	Version  int
+	Vertices []V
+	Rank     []int
+	Arcs     []arc
+}
+
+// Save writes h to w with encoding/gob, the vertices must be encodable by it
+// like string or int ids. Interface vertices are not, use their ids
+func (h *Hierarchy[V]) Save(w io.Writer) error {
+	return gob.NewEncoder(w).Encode(saved[V]{
+		Version:  formatVersion,
+		Vertices: h.vertices,
+		Rank:     h.rank,
+		Arcs:     h.arcs,
+	})
+}
+
+// Load reads a Hierarchy written by Save
+func Load[V comparable](r io.Reader) (*Hierarchy[V], error) {
+	var s saved[V]
+	if err := gob.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("decoding hierarchy: %w", err)
+	}
+	if s.Version != formatVersion {
+		return nil, fmt.Errorf("unsupported hierarchy format version %d", s.Version)
+	}
+
+	n := len(s.Vertices)
+	if len(s.Rank) != n {
+		return nil, fmt.Errorf("hierarchy has %d ranks for %d vertices", len(s.Rank), n)
+	}
+	h := &Hierarchy[V]{
+		vertices: s.Vertices,
+		ids:      make(map[V]int, n),
+		rank:     s.Rank,
+		arcs:     s.Arcs,
+	}
+	for v, vertex := range h.vertices {
+		h.ids[vertex] = v
+	}
+
+	for i, a := range h.arcs {
+		if a.From < 0 || a.From >= n || a.To < 0 || a.To >= n {
+			return nil, fmt.Errorf("arc %d: vertex out of range", i)
+		}
+		if a.Middle >= 0 && (a.Children[0] < 0 || a.Children[0] >= i || a.Children[1] < 0 || a.Children[1] >= i) {
+			return nil, fmt.Errorf("arc %d: shortcut of unknown arcs", i)
+		}
+	}
+	h.index()
+	return h, nil
+}
@@ -0,0 +1,44 @@
+package ch_test
+
+import (
+	"bytes"
+	"fatdes/go_algo/shortest_path/ch"
+	"fatdes/go_algo/shortest_path/internal/testgraph"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Persist_TestSaveLoad(t *testing.T) {
+	h, _ := ch.FromLoader(testgraph.Lattice(15))
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, h.Save(buffer))
+
+	loaded, err := ch.Load[string](buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, h.VertexCount(), loaded.VertexCount())
+	assert.Equal(t, h.ShortcutCount(), loaded.ShortcutCount())
+
+	for i := 0; i < 100; i++ {
+		from, to := strconv.Itoa(i%15)+",0", "14,"+strconv.Itoa(i*7%15)
+		expected := h.Find(from, to)
+		actual := loaded.Find(from, to)
+		assert.Equal(t, expected.Found, actual.Found, from+" -> "+to)
+		assert.Equal(t, expected.Cost, actual.Cost, from+" -> "+to)
+		assert.Equal(t, expected.Edges, actual.Edges, from+" -> "+to)
+	}
+}
+
+func Test_Persist_TestLoadInvalid(t *testing.T) {
+	_, err := ch.Load[string](strings.NewReader("not a hierarchy"))
+	assert.Error(t, err)
+
+	// vertices of another type do not decode
+	h, _ := ch.FromLoader(testgraph.Small())
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, h.Save(buffer))
+	_, err = ch.Load[int](buffer)
+	assert.Error(t, err)
+}
@@ -0,0 +1,169 @@
+package ch
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+
+	"fatdes/go_algo/shortest_path"
+)
+
+// upward is one direction of a query, it only follows arcs to higher ranked vertices
+type upward struct {
+	cost map[int]int
+	// parent is the arc each vertex was reached through
+	parent map[int]int
+	pq     shortest_path.PriorityQueue
+	done   bool
+}
+
+func newUpward(start int) *upward {
+	u := &upward{
+		cost:   map[int]int{start: 0},
+		parent: map[int]int{},
+	}
+	u.push(start)
+	return u
+}
+
+// queued is a vertex in the queue with the cost it was pushed at
+type queued struct {
+	vertex, cost int
+}
+
+// push queues vertex at its current cost, stale items are skipped when popped
+func (u *upward) push(vertex int) {
+	q := queued{vertex: vertex, cost: u.cost[vertex]}
+	heap.Push(&u.pq, shortest_path.NewItem(q, func() int { return q.cost }))
+}
+
+// Find the cheapest path from -> to, shortcuts are unpacked into the
+// original edges. Vertices not in the hierarchy are not found
+func (h *Hierarchy[V]) Find(from, to V) (path *shortest_path.Path[V, Edge[V], int]) {
+	stats := shortest_path.Stats{}
+	defer func() {
+		path.Stats = stats
+	}()
+
+	source, found := h.ids[from]
+	if !found {
+		return &shortest_path.Path[V, Edge[V], int]{Found: false}
+	}
+	target, found := h.ids[to]
+	if !found {
+		return &shortest_path.Path[V, Edge[V], int]{Found: false}
+	}
+
+	// the forward search follows up arcs from the source, the backward one
+	// follows down arcs against their direction from the target
+	forward, backward := newUpward(source), newUpward(target)
+	stats.Pushes = 2
+	stats.PeakQueue = 2
+	meet, best := -1, 0
+
+	for !forward.done || !backward.done {
+		for _, side := range []*upward{forward, backward} {
+			if side.done {
+				continue
+			}
+			if side.pq.Len() == 0 {
+				side.done = true
+				continue
+			}
+
+			q := heap.Pop(&side.pq).(*shortest_path.Item).Value().(queued)
+			v, cost := q.vertex, q.cost
+			if cost > side.cost[v] {
+				continue
+			}
+			// the cheapest path meets at a vertex settled by both sides, no
+			// cheaper one can be found once the queue passes it
+			if meet >= 0 && cost >= best {
+				side.done = true
+				continue
+			}
+			stats.Expansions++
+
+			other := backward
+			arcs := h.up[v]
+			if side == backward {
+				other = forward
+				arcs = h.down[v]
+			}
+			if otherCost, reached := other.cost[v]; reached && cost <= math.MaxInt-otherCost && (meet < 0 || cost+otherCost < best) {
+				meet, best = v, cost+otherCost
+			}
+
+			for _, a := range arcs {
+				end := h.arcs[a].To
+				if side == backward {
+					end = h.arcs[a].From
+				}
+				if cost > math.MaxInt-h.arcs[a].Cost {
+					err := fmt.Errorf("%w: %v -> %v", shortest_path.ErrCostOverflow, h.vertices[h.arcs[a].From], h.vertices[h.arcs[a].To])
+					return &shortest_path.Path[V, Edge[V], int]{Found: false, Err: err}
+				}
+				total := cost + h.arcs[a].Cost
+				if current, reached := side.cost[end]; reached && current <= total {
+					continue
+				}
+				side.cost[end] = total
+				side.parent[end] = a
+				side.push(end)
+				stats.Pushes++
+				if queued := forward.pq.Len() + backward.pq.Len(); queued > stats.PeakQueue {
+					stats.PeakQueue = queued
+				}
+			}
+		}
+	}
+
+	if meet < 0 {
+		return &shortest_path.Path[V, Edge[V], int]{Found: false}
+	}
+
+	// arcs from the source up to the meeting vertex, then down to the target
+	arcs := []int{}
+	for v := meet; v != source; v = h.arcs[forward.parent[v]].From {
+		arcs = append(arcs, forward.parent[v])
+	}
+	for i, j := 0, len(arcs)-1; i < j; i, j = i+1, j-1 {
+		arcs[i], arcs[j] = arcs[j], arcs[i]
+	}
+	for v := meet; v != target; v = h.arcs[backward.parent[v]].To {
+		arcs = append(arcs, backward.parent[v])
+	}
+
+	path = &shortest_path.Path[V, Edge[V], int]{
+		Found:    true,
+		Cost:     best,
+		Vertices: []V{from},
+		Edges:    []Edge[V]{},
+	}
+	for _, a := range h.unpack(arcs) {
+		edge := Edge[V]{From: h.vertices[h.arcs[a].From], To: h.vertices[h.arcs[a].To], Cost: h.arcs[a].Cost}
+		path.Vertices = append(path.Vertices, edge.To)
+		path.Edges = append(path.Edges, edge)
+	}
+	return path
+}
+
+// unpack replaces shortcuts by the original arcs they are made of
+func (h *Hierarchy[V]) unpack(arcs []int) []int {
+	original := []int{}
+	// stack holds the arcs left to unpack, last first
+	stack := make([]int, 0, len(arcs))
+	for i := len(arcs) - 1; i >= 0; i-- {
+		stack = append(stack, arcs[i])
+	}
+	for len(stack) > 0 {
+		a := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if h.arcs[a].Middle < 0 {
+			original = append(original, a)
+			continue
+		}
+		stack = append(stack, h.arcs[a].Children[1], h.arcs[a].Children[0])
+	}
+	return original
+}
@@ -0,0 +1,80 @@
+package ch_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/shortest_path/ch"
+	"fatdes/go_algo/shortest_path/internal/testgraph"
+	"fatdes/go_algo/shortest_path/loader"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Query_TestFind(t *testing.T) {
+	h, _ := ch.FromLoader(testgraph.Small())
+
+	actual := h.Find("a", "g")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 8, actual.Cost)
+	assert.Equal(t, []string{"a", "d", "f", "g"}, actual.Vertices)
+	assert.Equal(t, []ch.Edge[string]{
+		{From: "a", To: "d", Cost: 3},
+		{From: "d", To: "f", Cost: 2},
+		{From: "f", To: "g", Cost: 3},
+	}, actual.Edges)
+	assert.Greater(t, actual.Stats.Expansions, 0)
+
+	actual = h.Find("a", "a")
+	assert.True(t, actual.Found)
+	assert.Equal(t, []string{"a"}, actual.Vertices)
+	assert.Empty(t, actual.Edges)
+
+	assert.False(t, h.Find("a", "h").Found)
+	assert.False(t, h.Find("a", "x").Found)
+	assert.False(t, h.Find("x", "a").Found)
+	assert.Equal(t, 9, h.Find("h", "g").Cost)
+}
+
+func Test_Query_TestSameAsUniformCost(t *testing.T) {
+	testSameAsUniformCost(t, testgraph.Random(200, 3))
+	testSameAsUniformCost(t, testgraph.Lattice(20))
+}
+
+func testSameAsUniformCost(t *testing.T, graph *loader.Graph) {
+	h, err := ch.FromLoader(graph)
+	assert.NoError(t, err)
+	uc := shortest_path.NewUniformCost[string, *loader.Edge](graph)
+
+	for i := 0; i < 200; i++ {
+		from, to := testgraph.QueryVertices(graph, i)
+		expected := uc.Find(from, to)
+		actual := h.Find(from, to)
+		assert.Equal(t, expected.Found, actual.Found, from+" -> "+to)
+		assert.Equal(t, expected.Cost, actual.Cost, from+" -> "+to)
+		if !actual.Found {
+			continue
+		}
+
+		// the unpacked path is made of original edges adding up to the cost
+		assert.Equal(t, from, actual.Source())
+		assert.Equal(t, to, actual.Goal())
+		assert.Len(t, actual.Edges, len(actual.Vertices)-1)
+		total := 0
+		for j, edge := range actual.Edges {
+			assert.Equal(t, actual.Vertices[j], edge.From)
+			assert.Equal(t, actual.Vertices[j+1], edge.To)
+			assert.True(t, hasTestEdge(graph, edge), edge.From+" -> "+edge.To)
+			total += edge.Cost
+		}
+		assert.Equal(t, actual.Cost, total)
+	}
+}
+
+func hasTestEdge(graph *loader.Graph, edge ch.Edge[string]) bool {
+	for _, e := range graph.Edges(edge.From) {
+		if e.To == edge.To && e.Cost == edge.Cost {
+			return true
+		}
+	}
+	return false
+}
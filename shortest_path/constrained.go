@@ -0,0 +1,158 @@
+package shortest_path
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+)
+
+// ErrNegativeUsage is reported by FindConstrained for an edge using a negative
+// amount of a Resource
+var ErrNegativeUsage = errors.New("negative resource usage")
+
+// Resource is a secondary quantity accumulated along a path, like tolls or fuel
+type Resource[E any] struct {
+	Name string
+	// Usage of edge, FindConstrained fails with ErrNegativeUsage if it is negative
+	Usage func(edge E) int
+	// Budget is the most a path may use
+	Budget int
+}
+
+// Constraints a path must satisfy besides being cheapest
+type Constraints[E any] struct {
+	// MaxHops is the number of edges a path may have, zero means no limit
+	MaxHops   int
+	Resources []Resource[E]
+}
+
+// label is a node with the resources used on its path, a vertex keeps every
+// label not dominated by another one
+type label[V comparable, E any, C any] struct {
+	*node[V, E, C]
+	resources []int
+
+	// item is the queue entry of the label
+	item *Item
+}
+
+// dominates returns true if l is no worse than other in cost, hops when
+// limited, and every resource
+func (s *Search[V, E, C]) dominates(l, other *label[V, E, C], hops bool) bool {
+	if s.costs.less(other.totalCost, l.totalCost) {
+		return false
+	}
+	if hops && other.depth < l.depth {
+		return false
+	}
+	for i, used := range l.resources {
+		if other.resources[i] < used {
+			return false
+		}
+	}
+	return true
+}
+
+// FindConstrained finds the cheapest path from -> to within the hop limit
+// and resource budgets of constraints, Path.Resources holds how much of each
+// resource was used. Negative edge costs are refused, also by Bellman-Ford searches
+func (s *Search[V, E, C]) FindConstrained(from, to V, constraints *Constraints[E]) (path *Path[V, E, C]) {
+	probe := s.newProbe()
+	defer func() {
+		path.Stats = probe.stats
+	}()
+
+	if constraints == nil {
+		constraints = &Constraints[E]{}
+	}
+	hops := constraints.MaxHops > 0
+
+	less := func(value, other interface{}) bool {
+		return s.lessNode(value.(*label[V, E, C]).node, other.(*label[V, E, C]).node)
+	}
+	initial, err := s.withEstimate(&node[V, E, C]{vertex: from}, s.estimate(from, to))
+	if err != nil {
+		return &Path[V, E, C]{Found: false, Err: err}
+	}
+	initialLabel := &label[V, E, C]{node: initial, resources: make([]int, len(constraints.Resources))}
+	initialLabel.item = NewLessItem(initialLabel, less)
+
+	pq := PriorityQueue{initialLabel.item}
+	heap.Init(&pq)
+	labels := map[V][]*label[V, E, C]{from: {initialLabel}}
+	probe.pushed(initial, 1)
+
+	for pq.Len() > 0 {
+		l := heap.Pop(&pq).(*Item).value.(*label[V, E, C])
+		probe.popped(l.node)
+
+		if l.vertex == to {
+			path := l.toPath()
+			path.Resources = l.resources
+			return path
+		}
+
+		if hops && l.depth >= constraints.MaxHops {
+			continue
+		}
+		probe.settled(l.node)
+
+	edges:
+		for _, edge := range s.graph.Edges(l.vertex) {
+			end := s.graph.EdgeEnd(edge)
+			cost := s.graph.EdgeCost(edge)
+			if s.costs.negative(cost) {
+				err := fmt.Errorf("%w: %v -> %v costs %v", ErrNegativeCost, l.vertex, end, cost)
+				return &Path[V, E, C]{Found: false, Err: err}
+			}
+			totalCost, err := s.costs.sum(l.totalCost, cost)
+			if err != nil {
+				return &Path[V, E, C]{Found: false, Err: err}
+			}
+
+			resources := make([]int, len(l.resources))
+			for i, resource := range constraints.Resources {
+				usage := resource.Usage(edge)
+				if usage < 0 {
+					err := fmt.Errorf("%w: %v -> %v uses %d of %s", ErrNegativeUsage, l.vertex, end, usage, resource.Name)
+					return &Path[V, E, C]{Found: false, Err: err}
+				}
+				// compared before adding, a large usage must not overflow past the budget
+				if usage > resource.Budget-l.resources[i] {
+					probe.relaxed(l.node, end, edge, totalCost, false)
+					continue edges
+				}
+				resources[i] = l.resources[i] + usage
+			}
+
+			next := &label[V, E, C]{node: l.extend(end, edge, totalCost), resources: resources}
+			kept := labels[end][:0]
+			for _, other := range labels[end] {
+				if s.dominates(other, next, hops) {
+					probe.relaxed(l.node, end, edge, totalCost, false)
+					continue edges
+				}
+			}
+			probe.relaxed(l.node, end, edge, totalCost, true)
+			for _, other := range labels[end] {
+				if !s.dominates(next, other, hops) {
+					kept = append(kept, other)
+					continue
+				}
+				if other.item.index >= 0 {
+					pq.Remove(other.item)
+				}
+			}
+
+			if next.node, err = s.withEstimate(next.node, s.estimate(end, to)); err != nil {
+				return &Path[V, E, C]{Found: false, Err: err}
+			}
+			next.item = NewLessItem(next, less)
+			heap.Push(&pq, next.item)
+			labels[end] = append(kept, next)
+			probe.pushed(next.node, pq.Len())
+		}
+	}
+
+	return &Path[V, E, C]{Found: false}
+}
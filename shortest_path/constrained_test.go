@@ -0,0 +1,164 @@
+package shortest_path_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestConstrainedGraph() *testTypedGraph {
+	graph := &testTypedGraph{edges: map[string][]*testTypedEdge{}}
+	graph.addEdge("s", "a", 1).addEdge("s", "t", 10).addEdge("s", "c", 2)
+	graph.addEdge("a", "b", 1)
+	graph.addEdge("b", "t", 1)
+	graph.addEdge("c", "t", 2)
+	return graph
+}
+
+var testTolls = map[string]int{"a_b": 5, "c_t": 3}
+
+func testToll(edge *testTypedEdge) int {
+	return testTolls[edge.from+"_"+edge.to]
+}
+
+func Test_Constrained_TestMaxHops(t *testing.T) {
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](newTestConstrainedGraph())
+
+	actual := uc.FindConstrained("s", "t", nil)
+	assert.True(t, actual.Found)
+	assert.Equal(t, 3, actual.Cost)
+	assert.Equal(t, []string{"s", "a", "b", "t"}, actual.Vertices)
+
+	actual = uc.FindConstrained("s", "t", &shortest_path.Constraints[*testTypedEdge]{MaxHops: 2})
+	assert.True(t, actual.Found)
+	assert.Equal(t, 4, actual.Cost)
+	assert.Equal(t, []string{"s", "c", "t"}, actual.Vertices)
+
+	actual = uc.FindConstrained("s", "t", &shortest_path.Constraints[*testTypedEdge]{MaxHops: 1})
+	assert.True(t, actual.Found)
+	assert.Equal(t, 10, actual.Cost)
+
+	actual = uc.FindConstrained("s", "b", &shortest_path.Constraints[*testTypedEdge]{MaxHops: 1})
+	assert.False(t, actual.Found)
+}
+
+func Test_Constrained_TestBudget(t *testing.T) {
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](newTestConstrainedGraph())
+
+	budget := func(budget int) *shortest_path.Constraints[*testTypedEdge] {
+		return &shortest_path.Constraints[*testTypedEdge]{
+			Resources: []shortest_path.Resource[*testTypedEdge]{
+				{Name: "toll", Usage: testToll, Budget: budget},
+			},
+		}
+	}
+
+	actual := uc.FindConstrained("s", "t", budget(5))
+	assert.True(t, actual.Found)
+	assert.Equal(t, 3, actual.Cost)
+	assert.Equal(t, []int{5}, actual.Resources)
+
+	actual = uc.FindConstrained("s", "t", budget(3))
+	assert.True(t, actual.Found)
+	assert.Equal(t, 4, actual.Cost)
+	assert.Equal(t, []int{3}, actual.Resources)
+
+	actual = uc.FindConstrained("s", "t", budget(0))
+	assert.True(t, actual.Found)
+	assert.Equal(t, 10, actual.Cost)
+	assert.Equal(t, []int{0}, actual.Resources)
+}
+
+func Test_Constrained_TestKeepsNonDominatedLabels(t *testing.T) {
+	graph := &testTypedGraph{edges: map[string][]*testTypedEdge{}}
+	graph.addEdge("s", "x", 1).addEdge("s", "y", 3)
+	graph.addEdge("x", "m", 1)
+	graph.addEdge("y", "m", 1)
+	graph.addEdge("m", "t", 1)
+	tolls := map[string]int{"s_x": 2, "m_t": 2}
+
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](graph)
+
+	// the cheapest label at m uses too much toll to reach t
+	actual := uc.FindConstrained("s", "t", &shortest_path.Constraints[*testTypedEdge]{
+		Resources: []shortest_path.Resource[*testTypedEdge]{{
+			Name:   "toll",
+			Usage:  func(edge *testTypedEdge) int { return tolls[edge.from+"_"+edge.to] },
+			Budget: 3,
+		}},
+	})
+	assert.True(t, actual.Found)
+	assert.Equal(t, 5, actual.Cost)
+	assert.Equal(t, []string{"s", "y", "m", "t"}, actual.Vertices)
+	assert.Equal(t, []int{2}, actual.Resources)
+}
+
+func Test_ConstrainedByFunc_TestBudget(t *testing.T) {
+	graph := &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+	graph.buildTestByFuncGraph()
+
+	uc := shortest_path.NewUniformCostByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost)
+
+	actual := uc.FindConstrained("a", "g", &shortest_path.Constraints[interface{}]{
+		Resources: []shortest_path.Resource[interface{}]{{
+			Name: "toll",
+			Usage: func(edge interface{}) int {
+				if edge == "f_g" {
+					return 1
+				}
+				return 0
+			},
+			Budget: 0,
+		}},
+	})
+	assert.True(t, actual.Found)
+	assert.Equal(t, 14, actual.Cost)
+	assert.Equal(t, "a,b,c,g", ByFuncString(actual.Path))
+	assert.Equal(t, []int{0}, actual.Resources)
+
+	actual = uc.FindConstrained(nil, "g", nil)
+	assert.False(t, actual.Found)
+}
+
+func Test_ConstrainedByInterface_TestMaxHops(t *testing.T) {
+	graph := &testByInterfaceGraph{}
+	graph.buildTestByInterfaceGraph()
+
+	uc := shortest_path.NewUniformCostByInterface().(shortest_path.ConstrainedFinder)
+
+	actual := uc.FindConstrained(graph.vs["a"], graph.vs["c"], &shortest_path.Constraints[interface{}]{
+		MaxHops: 2,
+		Resources: []shortest_path.Resource[interface{}]{{
+			Name:   "edges",
+			Usage:  func(edge interface{}) int { return 1 },
+			Budget: 2,
+		}},
+	})
+	assert.True(t, actual.Found)
+	assert.Equal(t, 6, actual.Cost)
+	assert.Equal(t, "a,b,c", ByInterfaceString(actual.Path))
+	assert.Equal(t, []int{2}, actual.Resources)
+
+	actual = uc.FindConstrained(graph.vs["a"], graph.vs["c"], &shortest_path.Constraints[interface{}]{MaxHops: 1})
+	assert.False(t, actual.Found)
+}
+
+func Test_Constrained_TestNegativeUsage(t *testing.T) {
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](newTestConstrainedGraph())
+
+	refund := func(edge *testTypedEdge) int {
+		if edge.from == "c" {
+			return -3
+		}
+		return testToll(edge)
+	}
+	actual := uc.FindConstrained("s", "t", &shortest_path.Constraints[*testTypedEdge]{
+		Resources: []shortest_path.Resource[*testTypedEdge]{
+			{Name: "toll", Usage: refund, Budget: 5},
+		},
+	})
+	assert.False(t, actual.Found)
+	assert.ErrorIs(t, actual.Err, shortest_path.ErrNegativeUsage)
+	assert.Contains(t, actual.Err.Error(), "c -> t uses -3 of toll")
+}
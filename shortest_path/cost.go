@@ -0,0 +1,87 @@
+package shortest_path
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrCostOverflow is reported when an accumulated int cost overflows
+var ErrCostOverflow = errors.New("cost overflow")
+
+// Cost is implemented by user defined cost types C. The zero value of C must
+// be the cost of an empty path and adding a cost must never make a path
+// cheaper, except in Bellman-Ford searches
+type Cost[C any] interface {
+	Add(other C) C
+	Less(other C) bool
+}
+
+// Numeric are the built-in cost types, other cost types implement Cost
+type Numeric interface {
+	int | float64
+}
+
+// costs is the arithmetic of a cost type
+type costs[C any] struct {
+	// add returns false if the sum overflows
+	add  func(a, b C) (C, bool)
+	less func(a, b C) bool
+}
+
+// costsOf returns the arithmetic of C, which must be int, float64 or implement Cost
+func costsOf[C any]() costs[C] {
+	var zero C
+	switch any(zero).(type) {
+	case int:
+		return any(costs[int]{
+			add: func(a, b int) (int, bool) {
+				sum := a + b
+				return sum, (sum > a) == (b > 0)
+			},
+			less: func(a, b int) bool {
+				return a < b
+			},
+		}).(costs[C])
+	case float64:
+		return any(costs[float64]{
+			add: func(a, b float64) (float64, bool) {
+				return a + b, true
+			},
+			less: func(a, b float64) bool {
+				return a < b
+			},
+		}).(costs[C])
+	}
+
+	if _, ok := any(zero).(Cost[C]); !ok {
+		panic(fmt.Sprintf("cost type %T must be int, float64 or implement Cost", zero))
+	}
+
+	return costs[C]{
+		add: func(a, b C) (C, bool) {
+			return any(a).(Cost[C]).Add(b), true
+		},
+		less: func(a, b C) bool {
+			return any(a).(Cost[C]).Less(b)
+		},
+	}
+}
+
+// sum adds the costs, reporting ErrCostOverflow
+func (c costs[C]) sum(a, b C) (C, error) {
+	sum, ok := c.add(a, b)
+	if !ok {
+		return sum, fmt.Errorf("%w: %v + %v", ErrCostOverflow, a, b)
+	}
+	return sum, nil
+}
+
+func (c costs[C]) negative(cost C) bool {
+	var zero C
+	return c.less(cost, zero)
+}
+
+func (c costs[C]) zero(cost C) bool {
+	var zero C
+	return !c.less(cost, zero) && !c.less(zero, cost)
+}
@@ -0,0 +1,111 @@
+package shortest_path_test
+
+import (
+	"errors"
+	"fatdes/go_algo/shortest_path"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testFloatEdge struct {
+	to   string
+	cost float64
+}
+
+func newTestFloatGraph() shortest_path.Graph[string, testFloatEdge, float64] {
+	edges := map[string][]testFloatEdge{
+		"a": {{"b", 1.5}, {"c", 0.25}},
+		"b": {{"d", 0.5}},
+		"c": {{"d", 1.5}},
+	}
+	return shortest_path.NewFuncGraph(
+		func(vertex string) []testFloatEdge { return edges[vertex] },
+		func(edge testFloatEdge) string { return edge.to },
+		func(edge testFloatEdge) float64 { return edge.cost },
+	)
+}
+
+func Test_Cost_TestFloat(t *testing.T) {
+	uc := shortest_path.NewUniformCost(newTestFloatGraph())
+
+	actual := uc.Find("a", "d")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 1.75, actual.Cost)
+	assert.Equal(t, []string{"a", "c", "d"}, actual.Vertices)
+}
+
+// testTollCost orders by tolls first, then distance
+type testTollCost struct {
+	tolls, distance int
+}
+
+func (c testTollCost) Add(other testTollCost) testTollCost {
+	return testTollCost{c.tolls + other.tolls, c.distance + other.distance}
+}
+
+func (c testTollCost) Less(other testTollCost) bool {
+	if c.tolls != other.tolls {
+		return c.tolls < other.tolls
+	}
+	return c.distance < other.distance
+}
+
+type testTollEdge struct {
+	to   string
+	cost testTollCost
+}
+
+func Test_Cost_TestCustom(t *testing.T) {
+	edges := map[string][]testTollEdge{
+		"a": {{"b", testTollCost{1, 1}}, {"c", testTollCost{0, 5}}},
+		"b": {{"d", testTollCost{0, 1}}},
+		"c": {{"d", testTollCost{0, 5}}},
+	}
+	uc := shortest_path.NewUniformCost(shortest_path.NewFuncGraph(
+		func(vertex string) []testTollEdge { return edges[vertex] },
+		func(edge testTollEdge) string { return edge.to },
+		func(edge testTollEdge) testTollCost { return edge.cost },
+	))
+
+	actual := uc.Find("a", "d")
+	assert.True(t, actual.Found)
+	assert.Equal(t, testTollCost{0, 10}, actual.Cost)
+	assert.Equal(t, []string{"a", "c", "d"}, actual.Vertices)
+
+	ks := uc.FindK("a", "d", 2)
+	assert.Len(t, ks, 2)
+	assert.Equal(t, testTollCost{1, 2}, ks[1].Cost)
+}
+
+func Test_Cost_TestOverflow(t *testing.T) {
+	uc := shortest_path.NewUniformCost[int, int, int](shortest_path.NewFuncGraph(
+		func(vertex int) []int { return []int{vertex + 1} },
+		func(edge int) int { return edge },
+		func(edge int) int { return math.MaxInt / 2 },
+	))
+
+	actual := uc.Find(0, 5)
+	assert.False(t, actual.Found)
+	assert.True(t, errors.Is(actual.Err, shortest_path.ErrCostOverflow))
+
+	legacy := shortest_path.NewUniformCostByFunc(
+		func(vertex interface{}) []interface{} { return []interface{}{vertex.(int) + 1} },
+		func(edge interface{}) interface{} { return edge },
+		func(edge interface{}) int { return math.MaxInt / 2 },
+	)
+	result := legacy.Find(0, 5)
+	assert.False(t, result.Found)
+	assert.True(t, errors.Is(result.Err, shortest_path.ErrCostOverflow))
+}
+
+func Test_Cost_TestUnsupported(t *testing.T) {
+	assert.Panics(t, func() {
+		shortest_path.NewUniformCost(shortest_path.NewFuncGraph(
+			func(vertex string) []string { return nil },
+			func(edge string) string { return edge },
+			func(edge string) string { return edge },
+		))
+	})
+}
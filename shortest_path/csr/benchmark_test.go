@@ -0,0 +1,47 @@
+package csr_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/shortest_path/csr"
+	"fatdes/go_algo/shortest_path/internal/testgraph"
+	"fatdes/go_algo/shortest_path/loader"
+	"testing"
+)
+
+const (
+	benchVertices = 100000
+	benchDegree   = 4
+)
+
+func BenchmarkLoaderUniformCost(b *testing.B) {
+	graph := testgraph.Random(benchVertices, benchDegree)
+	uc := shortest_path.NewUniformCost[string, *loader.Edge](graph)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		uc.Find(testgraph.QueryVertices(graph, i))
+	}
+}
+
+func BenchmarkCSRUniformCost(b *testing.B) {
+	g, _ := csr.FromLoader(testgraph.Random(benchVertices, benchDegree))
+	uc := shortest_path.NewUniformCost[int, int](g)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		uc.Find(testgraph.Query(i, benchVertices))
+	}
+}
+
+func BenchmarkCSRSearcher(b *testing.B) {
+	g, _ := csr.FromLoader(testgraph.Random(benchVertices, benchDegree))
+	s := g.NewSearcher()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Find(testgraph.Query(i, benchVertices))
+	}
+}
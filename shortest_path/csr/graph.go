@@ -0,0 +1,117 @@
+// Package csr freezes a graph into compressed sparse rows with dense int
+// vertex ids, for searches that do not allocate or hash per vertex
+package csr
+
+import (
+	"fmt"
+
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/shortest_path/loader"
+)
+
+// Graph in compressed sparse row form: the edges of vertex v are
+// offsets[v] to offsets[v+1]. It implements shortest_path.Graph[int, int, int]
+// with edges numbered from 0, and is safe for concurrent use
+type Graph[V comparable] struct {
+	offsets []int
+	targets []int
+	costs   []int
+	// edgeIDs is 0 to the number of edges, Edges slices it
+	edgeIDs []int
+
+	vertices []V
+	ids      map[V]int
+}
+
+// Build freezes the part of graph reachable from vertices, which are numbered
+// in the order they are discovered. Edge costs must not be negative
+func Build[V comparable, E any](graph shortest_path.Graph[V, E, int], vertices []V) (*Graph[V], error) {
+	g := &Graph[V]{
+		offsets: []int{},
+		ids:     map[V]int{},
+	}
+	discover := func(v V) int {
+		id, found := g.ids[v]
+		if !found {
+			id = len(g.vertices)
+			g.ids[v] = id
+			g.vertices = append(g.vertices, v)
+		}
+		return id
+	}
+	for _, v := range vertices {
+		discover(v)
+	}
+
+	// vertices are appended while their predecessors are read, so this visits all of them
+	for id := 0; id < len(g.vertices); id++ {
+		v := g.vertices[id]
+		g.offsets = append(g.offsets, len(g.targets))
+		for _, edge := range graph.Edges(v) {
+			cost := graph.EdgeCost(edge)
+			end := graph.EdgeEnd(edge)
+			if cost < 0 {
+				return nil, fmt.Errorf("%w: %v -> %v costs %d", shortest_path.ErrNegativeCost, v, end, cost)
+			}
+			g.targets = append(g.targets, discover(end))
+			g.costs = append(g.costs, cost)
+		}
+	}
+	g.offsets = append(g.offsets, len(g.targets))
+
+	g.edgeIDs = make([]int, len(g.targets))
+	for i := range g.edgeIDs {
+		g.edgeIDs[i] = i
+	}
+	return g, nil
+}
+
+// FromInterface freezes the Vertex graph reachable from vertices
+func FromInterface(vertices ...shortest_path.Vertex) (*Graph[shortest_path.Vertex], error) {
+	return Build(shortest_path.NewInterfaceGraph(), vertices)
+}
+
+// FromLoader freezes a loaded graph, vertices keep the order of graph.Vertices
+func FromLoader(graph *loader.Graph) (*Graph[string], error) {
+	return Build[string, *loader.Edge](graph, graph.Vertices)
+}
+
+// VertexCount returns the number of vertices
+func (g *Graph[V]) VertexCount() int {
+	return len(g.vertices)
+}
+
+// EdgeCount returns the number of edges
+func (g *Graph[V]) EdgeCount() int {
+	return len(g.targets)
+}
+
+// ID returns the dense id of vertex
+func (g *Graph[V]) ID(vertex V) (int, bool) {
+	id, found := g.ids[vertex]
+	return id, found
+}
+
+// Vertex returns the vertex of id
+func (g *Graph[V]) Vertex(id int) V {
+	return g.vertices[id]
+}
+
+// Edges returns the ids of the edges of vertex, the slice must not be modified
+func (g *Graph[V]) Edges(vertex int) []int {
+	return g.edgeIDs[g.offsets[vertex]:g.offsets[vertex+1]]
+}
+
+func (g *Graph[V]) EdgeEnd(edge int) int {
+	return g.targets[edge]
+}
+
+func (g *Graph[V]) EdgeCost(edge int) int {
+	return g.costs[edge]
+}
+
+// Memory returns the approximate number of bytes used by the rows, without the vertex index
+func (g *Graph[V]) Memory() int {
+	const word = 8
+	return word * (len(g.offsets) + len(g.targets) + len(g.costs) + len(g.edgeIDs))
+}
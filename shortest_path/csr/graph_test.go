@@ -0,0 +1,73 @@
+package csr_test
+
+import (
+	"errors"
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/shortest_path/adjacency"
+	"fatdes/go_algo/shortest_path/csr"
+	"fatdes/go_algo/shortest_path/internal/testgraph"
+	"fatdes/go_algo/shortest_path/loader"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ shortest_path.Graph[int, int, int] = &csr.Graph[string]{}
+
+func Test_Graph_TestFromLoader(t *testing.T) {
+	g, err := csr.FromLoader(testgraph.Small())
+	assert.NoError(t, err)
+	assert.Equal(t, 8, g.VertexCount())
+	assert.Equal(t, 11, g.EdgeCount())
+
+	a, found := g.ID("a")
+	assert.True(t, found)
+	assert.Equal(t, "a", g.Vertex(a))
+	assert.Len(t, g.Edges(a), 2)
+	assert.Equal(t, "d", g.Vertex(g.EdgeEnd(g.Edges(a)[0])))
+	assert.Equal(t, 3, g.EdgeCost(g.Edges(a)[0]))
+	assert.Greater(t, g.Memory(), 0)
+
+	_, found = g.ID("x")
+	assert.False(t, found)
+
+	// the frozen graph also works with the generic searches
+	gid, _ := g.ID("g")
+	path := shortest_path.NewUniformCost[int, int](g).Find(a, gid)
+	assert.Equal(t, 8, path.Cost)
+}
+
+func Test_Graph_TestBuildReachable(t *testing.T) {
+	g, err := csr.Build[string, *loader.Edge](testgraph.Small(), []string{"e"})
+	assert.NoError(t, err)
+	// e reaches b, c and g but not a, d, f or h
+	assert.Equal(t, 4, g.VertexCount())
+	assert.Equal(t, "e", g.Vertex(0))
+	_, found := g.ID("a")
+	assert.False(t, found)
+}
+
+func Test_Graph_TestFromInterface(t *testing.T) {
+	adj := adjacency.New[string]()
+	adj.AddEdge("a", "b", 2)
+	adj.AddEdge("b", "c", 3)
+	a, _ := adj.Vertex("a")
+	c, _ := adj.Vertex("c")
+
+	g, err := csr.FromInterface(a)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, g.VertexCount())
+
+	path := g.NewSearcher().FindVertices(a, c)
+	assert.True(t, path.Found)
+	assert.Equal(t, 5, path.Cost)
+	assert.Equal(t, []shortest_path.Vertex{a, adj.AddVertex("b"), c}, path.Vertices)
+}
+
+func Test_Graph_TestNegativeCost(t *testing.T) {
+	graph := loader.NewGraph()
+	graph.AddEdge("a", "b", -1)
+
+	_, err := csr.FromLoader(graph)
+	assert.True(t, errors.Is(err, shortest_path.ErrNegativeCost))
+}
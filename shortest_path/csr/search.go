@@ -0,0 +1,234 @@
+package csr
+
+import (
+	"fmt"
+	"math"
+
+	"fatdes/go_algo/shortest_path"
+)
+
+// Searcher finds shortest paths on a Graph with buffers reused between
+// queries. A Searcher is not safe for concurrent use, use one per goroutine
+type Searcher[V comparable] struct {
+	graph *Graph[V]
+
+	cost []int
+	// parent is the edge into a reached vertex, -1 at the source
+	parent []int
+	// a vertex is reached or settled in the current query when its stamp is
+	// generation or generation+1, so buffers are not cleared between queries
+	stamp      []uint32
+	generation uint32
+
+	// heap of reached vertices ordered by cost, position[v] is the index of v
+	heap     []int
+	position []int
+}
+
+// NewSearcher creates a Searcher for g
+func (g *Graph[V]) NewSearcher() *Searcher[V] {
+	n := g.VertexCount()
+	return &Searcher[V]{
+		graph:    g,
+		cost:     make([]int, n),
+		parent:   make([]int, n),
+		stamp:    make([]uint32, n),
+		position: make([]int, n),
+		heap:     make([]int, 0, 64),
+	}
+}
+
+// next starts a new query
+func (s *Searcher[V]) next() {
+	if s.generation >= math.MaxUint32-2 {
+		for i := range s.stamp {
+			s.stamp[i] = 0
+		}
+		s.generation = 0
+	}
+	s.generation += 2
+	s.heap = s.heap[:0]
+}
+
+func (s *Searcher[V]) reached(v int) bool {
+	return s.stamp[v] >= s.generation
+}
+
+func (s *Searcher[V]) settled(v int) bool {
+	return s.stamp[v] == s.generation+1
+}
+
+func (s *Searcher[V]) swap(i, j int) {
+	s.heap[i], s.heap[j] = s.heap[j], s.heap[i]
+	s.position[s.heap[i]] = i
+	s.position[s.heap[j]] = j
+}
+
+func (s *Searcher[V]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if s.cost[s.heap[parent]] <= s.cost[s.heap[i]] {
+			return
+		}
+		s.swap(i, parent)
+		i = parent
+	}
+}
+
+func (s *Searcher[V]) down(i int) {
+	for {
+		smallest, left, right := i, 2*i+1, 2*i+2
+		if left < len(s.heap) && s.cost[s.heap[left]] < s.cost[s.heap[smallest]] {
+			smallest = left
+		}
+		if right < len(s.heap) && s.cost[s.heap[right]] < s.cost[s.heap[smallest]] {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		s.swap(i, smallest)
+		i = smallest
+	}
+}
+
+func (s *Searcher[V]) push(v int) {
+	s.position[v] = len(s.heap)
+	s.heap = append(s.heap, v)
+	s.up(len(s.heap) - 1)
+}
+
+func (s *Searcher[V]) pop() int {
+	v := s.heap[0]
+	last := len(s.heap) - 1
+	s.swap(0, last)
+	s.heap = s.heap[:last]
+	s.down(0)
+	return v
+}
+
+// Find the cheapest path from -> to by dense id, the path holds vertex ids
+// and edge ids. Ids out of range are not found
+func (s *Searcher[V]) Find(from, to int) *shortest_path.Path[int, int, int] {
+	n := s.graph.VertexCount()
+	if from < 0 || from >= n || to < 0 || to >= n {
+		return &shortest_path.Path[int, int, int]{Found: false}
+	}
+
+	s.next()
+	stats := shortest_path.Stats{Pushes: 1, PeakQueue: 1}
+	s.cost[from] = 0
+	s.parent[from] = -1
+	s.stamp[from] = s.generation
+	s.push(from)
+
+	for len(s.heap) > 0 {
+		v := s.pop()
+		s.stamp[v] = s.generation + 1
+		if v == to {
+			path := s.path(to)
+			path.Stats = stats
+			return path
+		}
+		stats.Expansions++
+
+		cost := s.cost[v]
+		for e := s.graph.offsets[v]; e < s.graph.offsets[v+1]; e++ {
+			end := s.graph.targets[e]
+			if s.settled(end) {
+				continue
+			}
+			edgeCost := s.graph.costs[e]
+			if cost > math.MaxInt-edgeCost {
+				err := fmt.Errorf("%w: %d + %d", shortest_path.ErrCostOverflow, cost, edgeCost)
+				return &shortest_path.Path[int, int, int]{Found: false, Stats: stats, Err: err}
+			}
+			total := cost + edgeCost
+
+			if s.reached(end) {
+				if total < s.cost[end] {
+					s.cost[end] = total
+					s.parent[end] = e
+					s.up(s.position[end])
+				}
+				continue
+			}
+
+			s.stamp[end] = s.generation
+			s.cost[end] = total
+			s.parent[end] = e
+			s.push(end)
+			stats.Pushes++
+			if len(s.heap) > stats.PeakQueue {
+				stats.PeakQueue = len(s.heap)
+			}
+		}
+	}
+
+	return &shortest_path.Path[int, int, int]{Found: false, Stats: stats}
+}
+
+// path follows the parent edges back from to
+func (s *Searcher[V]) path(to int) *shortest_path.Path[int, int, int] {
+	depth := 0
+	for v := to; s.parent[v] >= 0; v = s.source(s.parent[v]) {
+		depth++
+	}
+
+	vertices := make([]int, depth+1)
+	edges := make([]int, depth)
+	v := to
+	for i := depth; i > 0; i-- {
+		vertices[i] = v
+		edges[i-1] = s.parent[v]
+		v = s.source(s.parent[v])
+	}
+	vertices[0] = v
+
+	return &shortest_path.Path[int, int, int]{
+		Found:    true,
+		Cost:     s.cost[to],
+		Vertices: vertices,
+		Edges:    edges,
+	}
+}
+
+// source returns the start of edge, the vertex whose row holds it
+func (s *Searcher[V]) source(edge int) int {
+	offsets := s.graph.offsets
+	low, high := 0, len(offsets)-1
+	for low+1 < high {
+		mid := (low + high) / 2
+		if offsets[mid] <= edge {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return low
+}
+
+// FindVertices finds like Find by vertex instead of id, vertices not in the graph are not found
+func (s *Searcher[V]) FindVertices(from, to V) *shortest_path.Path[V, int, int] {
+	fromID, fromFound := s.graph.ID(from)
+	toID, toFound := s.graph.ID(to)
+	if !fromFound || !toFound {
+		return &shortest_path.Path[V, int, int]{Found: false}
+	}
+
+	path := s.Find(fromID, toID)
+	result := &shortest_path.Path[V, int, int]{
+		Found: path.Found,
+		Cost:  path.Cost,
+		Edges: path.Edges,
+		Stats: path.Stats,
+		Err:   path.Err,
+	}
+	if path.Found {
+		result.Vertices = make([]V, len(path.Vertices))
+		for i, id := range path.Vertices {
+			result.Vertices[i] = s.graph.Vertex(id)
+		}
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package csr_test
+
+import (
+	"errors"
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/shortest_path/csr"
+	"fatdes/go_algo/shortest_path/internal/testgraph"
+	"fatdes/go_algo/shortest_path/loader"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Search_TestFind(t *testing.T) {
+	g, _ := csr.FromLoader(testgraph.Small())
+	s := g.NewSearcher()
+
+	actual := s.FindVertices("a", "g")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 8, actual.Cost)
+	assert.Equal(t, []string{"a", "d", "f", "g"}, actual.Vertices)
+	assert.Len(t, actual.Edges, 3)
+	assert.Equal(t, "g", g.Vertex(g.EdgeEnd(actual.Edges[2])))
+	assert.Equal(t, shortest_path.Stats{Expansions: 6, Pushes: 7, PeakQueue: 3}, actual.Stats)
+
+	actual = s.FindVertices("a", "a")
+	assert.True(t, actual.Found)
+	assert.Equal(t, []string{"a"}, actual.Vertices)
+
+	assert.False(t, s.FindVertices("a", "h").Found)
+	assert.False(t, s.FindVertices("a", "x").Found)
+	assert.False(t, s.Find(0, 100).Found)
+	assert.False(t, s.Find(-1, 0).Found)
+
+	// buffers are reused, earlier queries must not leak into later ones
+	assert.Equal(t, 8, s.FindVertices("a", "g").Cost)
+	assert.Equal(t, 9, s.FindVertices("h", "g").Cost)
+}
+
+func Test_Search_TestSameAsUniformCost(t *testing.T) {
+	graph := testgraph.Random(500, 3)
+	g, _ := csr.FromLoader(graph)
+	s := g.NewSearcher()
+	uc := shortest_path.NewUniformCost[string, *loader.Edge](graph)
+
+	for i := 0; i < 200; i++ {
+		from, to := testgraph.QueryVertices(graph, i)
+		expected := uc.Find(from, to)
+		actual := s.FindVertices(from, to)
+		assert.Equal(t, expected.Found, actual.Found, from+" -> "+to)
+		assert.Equal(t, expected.Cost, actual.Cost, from+" -> "+to)
+	}
+}
+
+func Test_Search_TestOverflow(t *testing.T) {
+	graph := loader.NewGraph()
+	graph.AddEdge("a", "b", math.MaxInt)
+	graph.AddEdge("b", "c", 1)
+	g, _ := csr.FromLoader(graph)
+
+	actual := g.NewSearcher().FindVertices("a", "c")
+	assert.False(t, actual.Found)
+	assert.True(t, errors.Is(actual.Err, shortest_path.ErrCostOverflow))
+}
@@ -0,0 +1,334 @@
+package shortest_path
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnreachedPredecessor is reported by CriticalPath for an edge into Order
+// from a vertex outside of it, whose earliest start is unknown
+var ErrUnreachedPredecessor = errors.New("predecessor not reached")
+
+// CycleError names the vertices of a cycle found by TopologicalSort, the
+// first vertex is repeated at the end
+type CycleError[V comparable] struct {
+	Cycle []V
+}
+
+func (e *CycleError[V]) Error() string {
+	vertices := make([]string, len(e.Cycle))
+	for i, v := range e.Cycle {
+		vertices[i] = fmt.Sprintf("%v", v)
+	}
+	return fmt.Sprintf("cycle: %s", strings.Join(vertices, " -> "))
+}
+
+// TopologicalSort orders the vertices reachable from vertices so that every
+// edge goes from an earlier vertex to a later one. It returns a CycleError
+// if there is no such order
+func TopologicalSort[V comparable, E any, C any](graph Graph[V, E, C], vertices []V) ([]V, error) {
+	type frame struct {
+		vertex V
+		edges  []E
+		next   int
+	}
+	const (
+		visiting = 1
+		done     = 2
+	)
+
+	state := map[V]int{}
+	// finished holds the vertices in reverse order
+	finished := []V{}
+	stack := []*frame{}
+	for _, root := range vertices {
+		if state[root] != 0 {
+			continue
+		}
+		state[root] = visiting
+		stack = append(stack, &frame{vertex: root, edges: graph.Edges(root)})
+
+		for len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if top.next == len(top.edges) {
+				state[top.vertex] = done
+				finished = append(finished, top.vertex)
+				stack = stack[:len(stack)-1]
+				continue
+			}
+
+			end := graph.EdgeEnd(top.edges[top.next])
+			top.next++
+			switch state[end] {
+			case 0:
+				state[end] = visiting
+				stack = append(stack, &frame{vertex: end, edges: graph.Edges(end)})
+			case visiting:
+				// end is on the stack, the edge closes the cycle back to it
+				cycle := []V{}
+				for i := len(stack) - 1; ; i-- {
+					cycle = append(cycle, stack[i].vertex)
+					if stack[i].vertex == end {
+						break
+					}
+				}
+				for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				return nil, &CycleError[V]{Cycle: append(cycle, end)}
+			}
+		}
+	}
+
+	for i, j := 0, len(finished)-1; i < j; i, j = i+1, j-1 {
+		finished[i], finished[j] = finished[j], finished[i]
+	}
+	return finished, nil
+}
+
+// DAG searches an acyclic graph by relaxing its edges in topological order,
+// in linear time and without a queue. Edge costs may be negative, and the
+// most expensive paths can be found as well as the cheapest. The graph must
+// not change after NewDAG
+type DAG[V comparable, E any, C Numeric] struct {
+	// Order is the topological order of the vertices
+	Order []V
+
+	graph    Graph[V, E, C]
+	costs    costs[C]
+	position map[V]int
+}
+
+// NewDAG sorts the vertices reachable from vertices, it returns a CycleError
+// if the graph is not acyclic
+func NewDAG[V comparable, E any, C Numeric](graph Graph[V, E, C], vertices []V) (*DAG[V, E, C], error) {
+	order, err := TopologicalSort(graph, vertices)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &DAG[V, E, C]{
+		Order:    order,
+		graph:    graph,
+		costs:    costsOf[C](),
+		position: make(map[V]int, len(order)),
+	}
+	for i, v := range order {
+		d.position[v] = i
+	}
+	return d, nil
+}
+
+// NewDAGByInterface creates a DAG over the Vertex and Edge interfaces,
+// CriticalPath checks the in-edges of vertices that implement InVertex
+func NewDAGByInterface(vertices ...Vertex) (*DAG[Vertex, Edge, int], error) {
+	return NewDAG(NewInterfaceGraph(), vertices)
+}
+
+// relax returns the tree of cheapest, or most expensive when longest is
+// true, paths from the source to the vertices up to last in Order
+func (d *DAG[V, E, C]) relax(from V, last int, longest bool) *Tree[V, E, C] {
+	tree := newTree[V, E, C](from)
+	first, found := d.position[from]
+	// vertices before the source cannot be reached from it
+	if !found || last < first {
+		return tree
+	}
+
+	var zero C
+	tree.Cost[from] = zero
+	tree.Stats.Pushes++
+	for _, v := range d.Order[first : last+1] {
+		cost, reached := tree.Cost[v]
+		if !reached {
+			continue
+		}
+		tree.Stats.Expansions++
+
+		for _, edge := range d.graph.Edges(v) {
+			end := d.graph.EdgeEnd(edge)
+			total, err := d.costs.sum(cost, d.graph.EdgeCost(edge))
+			if err != nil {
+				failed := newTree[V, E, C](from)
+				failed.Stats = tree.Stats
+				failed.Err = err
+				return failed
+			}
+
+			current, reached := tree.Cost[end]
+			if reached && (!longest && current <= total || longest && current >= total) {
+				continue
+			}
+			if !reached {
+				tree.Stats.Pushes++
+			}
+			tree.Cost[end] = total
+			tree.Predecessor[end] = v
+			tree.PredecessorEdge[end] = edge
+		}
+	}
+	return tree
+}
+
+// contains returns true if v is in Order
+func (d *DAG[V, E, C]) contains(v V) bool {
+	_, found := d.position[v]
+	return found
+}
+
+// find returns the path from -> to of relax
+func (d *DAG[V, E, C]) find(from, to V, longest bool) *Path[V, E, C] {
+	last, found := d.position[to]
+	if !found {
+		return &Path[V, E, C]{Found: false}
+	}
+	// every vertex before to in Order may lead to it, none after
+	tree := d.relax(from, last, longest)
+	path := tree.PathTo(to)
+	path.Stats = tree.Stats
+	return path
+}
+
+// Find returns the cheapest path from -> to, not found if either is not in Order
+func (d *DAG[V, E, C]) Find(from, to V) *Path[V, E, C] {
+	return d.find(from, to, false)
+}
+
+// FindLongest returns the most expensive path from -> to, not found if
+// either is not in Order
+func (d *DAG[V, E, C]) FindLongest(from, to V) *Path[V, E, C] {
+	return d.find(from, to, true)
+}
+
+// FindAll returns the tree of cheapest paths from the source to every
+// reachable vertex, the tree is empty if from is not in Order
+func (d *DAG[V, E, C]) FindAll(from V) *Tree[V, E, C] {
+	return d.relax(from, len(d.Order)-1, false)
+}
+
+// FindAllLongest returns the tree of most expensive paths from the source to
+// every reachable vertex, the tree is empty if from is not in Order
+func (d *DAG[V, E, C]) FindAllLongest(from V) *Tree[V, E, C] {
+	return d.relax(from, len(d.Order)-1, true)
+}
+
+// Schedule is the critical path analysis of a DAG whose vertices are events
+// and whose edge costs are the durations between them
+type Schedule[V comparable, E any, C Numeric] struct {
+	// Length is the cost of the longest path, the duration of the whole DAG
+	Length C
+	// Critical is a longest path, from a vertex without in-edges
+	Critical *Path[V, E, C]
+
+	// Earliest is the cost of the longest path to every vertex from any
+	// vertex without in-edges, Latest the highest it can be without making
+	// Length longer
+	Earliest map[V]C
+	Latest   map[V]C
+	// Slack is Latest minus Earliest, zero on the critical paths
+	Slack map[V]C
+
+	// Err is set when the analysis could not complete, the maps are empty
+	Err error
+}
+
+// CriticalPath finds the longest path through the DAG and the slack of every
+// vertex. Edge costs must not be negative. When the graph is a ReverseGraph
+// it fails with ErrUnreachedPredecessor if a vertex has an in-edge from a
+// vertex not in Order, pass that vertex to NewDAG as well
+func (d *DAG[V, E, C]) CriticalPath() *Schedule[V, E, C] {
+	schedule := &Schedule[V, E, C]{
+		Critical: &Path[V, E, C]{Found: false},
+		Earliest: make(map[V]C, len(d.Order)),
+		Latest:   make(map[V]C, len(d.Order)),
+		Slack:    make(map[V]C, len(d.Order)),
+	}
+	failed := func(err error) *Schedule[V, E, C] {
+		return &Schedule[V, E, C]{
+			Critical: &Path[V, E, C]{Found: false, Err: err},
+			Earliest: map[V]C{},
+			Latest:   map[V]C{},
+			Slack:    map[V]C{},
+			Err:      err,
+		}
+	}
+	if len(d.Order) == 0 {
+		return schedule
+	}
+
+	if reverse, ok := d.graph.(ReverseGraph[V, E, C]); ok {
+		for _, v := range d.Order {
+			for _, edge := range reverse.InEdges(v) {
+				if start := reverse.EdgeStart(edge); !d.contains(start) {
+					return failed(fmt.Errorf("%w: %v -> %v", ErrUnreachedPredecessor, start, v))
+				}
+			}
+		}
+	}
+
+	// vertices are reached in order, those not reached have no in-edges and start at zero
+	var zero C
+	predecessor := map[V]V{}
+	predecessorEdge := map[V]E{}
+	last := d.Order[0]
+	for _, v := range d.Order {
+		earliest, reached := schedule.Earliest[v]
+		if !reached {
+			schedule.Earliest[v] = zero
+		}
+		if earliest > schedule.Earliest[last] {
+			last = v
+		}
+
+		for _, edge := range d.graph.Edges(v) {
+			end := d.graph.EdgeEnd(edge)
+			cost := d.graph.EdgeCost(edge)
+			if cost < 0 {
+				return failed(fmt.Errorf("%w: %v -> %v costs %v", ErrNegativeCost, v, end, cost))
+			}
+			total, err := d.costs.sum(earliest, cost)
+			if err != nil {
+				return failed(err)
+			}
+			if current, reached := schedule.Earliest[end]; !reached || total > current {
+				schedule.Earliest[end] = total
+				predecessor[end] = v
+				predecessorEdge[end] = edge
+			}
+		}
+	}
+	schedule.Length = schedule.Earliest[last]
+
+	// the latest of a vertex is the earliest any of its successors must start
+	for i := len(d.Order) - 1; i >= 0; i-- {
+		v := d.Order[i]
+		latest := schedule.Length
+		for _, edge := range d.graph.Edges(v) {
+			if start := schedule.Latest[d.graph.EdgeEnd(edge)] - d.graph.EdgeCost(edge); start < latest {
+				latest = start
+			}
+		}
+		schedule.Latest[v] = latest
+		schedule.Slack[v] = latest - schedule.Earliest[v]
+	}
+
+	critical := &Path[V, E, C]{Found: true, Cost: schedule.Length, Vertices: []V{last}, Edges: []E{}}
+	for v := last; ; {
+		edge, found := predecessorEdge[v]
+		if !found {
+			break
+		}
+		v = predecessor[v]
+		critical.Vertices = append(critical.Vertices, v)
+		critical.Edges = append(critical.Edges, edge)
+	}
+	for i, j := 0, len(critical.Vertices)-1; i < j; i, j = i+1, j-1 {
+		critical.Vertices[i], critical.Vertices[j] = critical.Vertices[j], critical.Vertices[i]
+	}
+	for i, j := 0, len(critical.Edges)-1; i < j; i, j = i+1, j-1 {
+		critical.Edges[i], critical.Edges[j] = critical.Edges[j], critical.Edges[i]
+	}
+	schedule.Critical = critical
+	return schedule
+}
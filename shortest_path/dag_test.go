@@ -0,0 +1,195 @@
+package shortest_path_test
+
+import (
+	"errors"
+	"fatdes/go_algo/shortest_path"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestPipelineGraph is a build pipeline, edges cost the duration of their start
+func newTestPipelineGraph() *testTypedGraph {
+	graph := &testTypedGraph{edges: map[string][]*testTypedEdge{}}
+	graph.addEdge("checkout", "build", 5).addEdge("checkout", "lint", 2)
+	graph.addEdge("build", "test", 10).addEdge("build", "package", 3)
+	graph.addEdge("lint", "package", 1)
+	graph.addEdge("test", "deploy", 4)
+	graph.addEdge("package", "deploy", 2)
+	return graph
+}
+
+func Test_DAG_TestTopologicalSort(t *testing.T) {
+	order, err := shortest_path.TopologicalSort[string, *testTypedEdge, int](newTestPipelineGraph(), []string{"checkout"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"checkout", "lint", "build", "package", "test", "deploy"}, order)
+
+	// only the vertices reachable from the given ones are sorted
+	order, err = shortest_path.TopologicalSort[string, *testTypedEdge, int](newTestPipelineGraph(), []string{"package", "lint"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"lint", "package", "deploy"}, order)
+}
+
+func Test_DAG_TestCycle(t *testing.T) {
+	order, err := shortest_path.TopologicalSort[string, *testTypedEdge, int](newTestTypedGraph(), []string{"a"})
+	assert.Nil(t, order)
+
+	var cycleErr *shortest_path.CycleError[string]
+	assert.True(t, errors.As(err, &cycleErr))
+	assert.Equal(t, []string{"e", "b", "c", "e"}, cycleErr.Cycle)
+	assert.Equal(t, "cycle: e -> b -> c -> e", err.Error())
+
+	graph := newTestPipelineGraph().addEdge("deploy", "deploy", 1)
+	_, err = shortest_path.NewDAG[string, *testTypedEdge](graph, []string{"checkout"})
+	assert.Equal(t, "cycle: deploy -> deploy", err.Error())
+}
+
+func Test_DAG_TestFind(t *testing.T) {
+	dag, err := shortest_path.NewDAG[string, *testTypedEdge](newTestPipelineGraph(), []string{"checkout"})
+	assert.NoError(t, err)
+
+	actual := dag.Find("checkout", "deploy")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 5, actual.Cost)
+	assert.Equal(t, []string{"checkout", "lint", "package", "deploy"}, actual.Vertices)
+	assert.Len(t, actual.Edges, 3)
+	assert.Equal(t, shortest_path.Stats{Expansions: 6, Pushes: 6}, actual.Stats)
+
+	actual = dag.FindLongest("checkout", "deploy")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 19, actual.Cost)
+	assert.Equal(t, []string{"checkout", "build", "test", "deploy"}, actual.Vertices)
+
+	actual = dag.Find("lint", "lint")
+	assert.True(t, actual.Found)
+	assert.Equal(t, []string{"lint"}, actual.Vertices)
+
+	assert.False(t, dag.Find("deploy", "checkout").Found)
+	assert.False(t, dag.Find("lint", "test").Found)
+	assert.False(t, dag.Find("checkout", "release").Found)
+	assert.False(t, dag.Find("release", "deploy").Found)
+}
+
+func Test_DAG_TestFindAll(t *testing.T) {
+	dag, _ := shortest_path.NewDAG[string, *testTypedEdge](newTestPipelineGraph(), []string{"checkout"})
+
+	tree := dag.FindAll("build")
+	assert.Equal(t, map[string]int{"build": 0, "test": 10, "package": 3, "deploy": 5}, tree.Cost)
+
+	tree = dag.FindAllLongest("build")
+	assert.Equal(t, map[string]int{"build": 0, "test": 10, "package": 3, "deploy": 14}, tree.Cost)
+	assert.Equal(t, "test", tree.Predecessor["deploy"])
+
+	assert.Empty(t, dag.FindAll("release").Cost)
+}
+
+func Test_DAG_TestNegativeCost(t *testing.T) {
+	dag, _ := shortest_path.NewDAG[string, *testTypedEdge](newTestNegativeGraph(), []string{"a"})
+
+	actual := dag.Find("a", "d")
+	assert.NoError(t, actual.Err)
+	assert.Equal(t, 0, actual.Cost)
+	assert.Equal(t, []string{"a", "c", "b", "d"}, actual.Vertices)
+	assert.Equal(t, 5, dag.FindLongest("a", "d").Cost)
+
+	schedule := dag.CriticalPath()
+	assert.True(t, errors.Is(schedule.Err, shortest_path.ErrNegativeCost))
+	assert.False(t, schedule.Critical.Found)
+	assert.Empty(t, schedule.Slack)
+}
+
+func Test_DAG_TestOverflow(t *testing.T) {
+	graph := &testTypedGraph{edges: map[string][]*testTypedEdge{}}
+	graph.addEdge("a", "b", math.MaxInt).addEdge("b", "c", 1)
+	dag, _ := shortest_path.NewDAG[string, *testTypedEdge](graph, []string{"a"})
+
+	actual := dag.Find("a", "c")
+	assert.False(t, actual.Found)
+	assert.True(t, errors.Is(actual.Err, shortest_path.ErrCostOverflow))
+	assert.True(t, errors.Is(dag.CriticalPath().Err, shortest_path.ErrCostOverflow))
+}
+
+func Test_DAG_TestCriticalPath(t *testing.T) {
+	dag, _ := shortest_path.NewDAG[string, *testTypedEdge](newTestPipelineGraph(), []string{"checkout"})
+
+	schedule := dag.CriticalPath()
+	assert.NoError(t, schedule.Err)
+	assert.Equal(t, 19, schedule.Length)
+	assert.True(t, schedule.Critical.Found)
+	assert.Equal(t, 19, schedule.Critical.Cost)
+	assert.Equal(t, []string{"checkout", "build", "test", "deploy"}, schedule.Critical.Vertices)
+	assert.Len(t, schedule.Critical.Edges, 3)
+
+	assert.Equal(t, map[string]int{"checkout": 0, "build": 5, "lint": 2, "test": 15, "package": 8, "deploy": 19}, schedule.Earliest)
+	assert.Equal(t, map[string]int{"checkout": 0, "build": 5, "lint": 16, "test": 15, "package": 17, "deploy": 19}, schedule.Latest)
+	assert.Equal(t, map[string]int{"checkout": 0, "build": 0, "lint": 14, "test": 0, "package": 9, "deploy": 0}, schedule.Slack)
+
+	empty, _ := shortest_path.NewDAG[string, *testTypedEdge](newTestPipelineGraph(), nil)
+	schedule = empty.CriticalPath()
+	assert.NoError(t, schedule.Err)
+	assert.False(t, schedule.Critical.Found)
+	assert.Empty(t, schedule.Earliest)
+}
+
+func Test_DAG_TestByInterface(t *testing.T) {
+	a, b, c, d := &testInVertex{id: "a"}, &testInVertex{id: "b"}, &testInVertex{id: "c"}, &testInVertex{id: "d"}
+	a.addEdge(b, 3).addEdge(c, 1)
+	b.addEdge(d, 2)
+	c.addEdge(b, 1).addEdge(d, 6)
+
+	dag, err := shortest_path.NewDAGByInterface(a)
+	assert.NoError(t, err)
+	assert.Equal(t, []shortest_path.Vertex{a, c, b, d}, dag.Order)
+	assert.Equal(t, 4, dag.Find(a, d).Cost)
+	assert.Equal(t, 7, dag.FindLongest(a, d).Cost)
+
+	schedule := dag.CriticalPath()
+	assert.Equal(t, []shortest_path.Vertex{a, c, d}, schedule.Critical.Vertices)
+	assert.Equal(t, 2, schedule.Slack[b])
+
+	d.addEdge(a, 1)
+	_, err = shortest_path.NewDAGByInterface(a)
+	var cycleErr *shortest_path.CycleError[shortest_path.Vertex]
+	assert.True(t, errors.As(err, &cycleErr))
+	assert.Equal(t, []shortest_path.Vertex{a, b, d, a}, cycleErr.Cycle)
+}
+
+func Test_DAG_TestUnreachedPredecessor(t *testing.T) {
+	graph := newTestPipelineGraph()
+	// review is a prerequisite of package that checkout does not lead to
+	graph.addEdge("review", "package", 20)
+	in := map[string][]*testTypedEdge{}
+	for _, edges := range graph.edges {
+		for _, edge := range edges {
+			in[edge.to] = append(in[edge.to], edge)
+		}
+	}
+	reverse := shortest_path.NewReverseFuncGraph(
+		graph.Edges, graph.EdgeEnd, graph.EdgeCost,
+		func(v string) []*testTypedEdge { return in[v] },
+		func(edge *testTypedEdge) string { return edge.from },
+	)
+
+	dag, _ := shortest_path.NewDAG(reverse, []string{"checkout"})
+	schedule := dag.CriticalPath()
+	assert.ErrorIs(t, schedule.Err, shortest_path.ErrUnreachedPredecessor)
+	assert.Contains(t, schedule.Err.Error(), "review -> package")
+	assert.False(t, schedule.Critical.Found)
+	assert.Empty(t, schedule.Earliest)
+
+	dag, _ = shortest_path.NewDAG(reverse, []string{"checkout", "review"})
+	schedule = dag.CriticalPath()
+	assert.NoError(t, schedule.Err)
+	assert.Equal(t, 22, schedule.Length)
+	assert.Equal(t, 20, schedule.Earliest["package"])
+	assert.Equal(t, []string{"review", "package", "deploy"}, schedule.Critical.Vertices)
+
+	a, b, c := &testInVertex{id: "a"}, &testInVertex{id: "b"}, &testInVertex{id: "c"}
+	a.addEdge(b, 1)
+	c.addEdge(b, 5)
+	byInterface, _ := shortest_path.NewDAGByInterface(a)
+	assert.ErrorIs(t, byInterface.CriticalPath().Err, shortest_path.ErrUnreachedPredecessor)
+	byInterface, _ = shortest_path.NewDAGByInterface(a, c)
+	assert.Equal(t, 5, byInterface.CriticalPath().Earliest[b])
+}
@@ -0,0 +1,310 @@
+package shortest_path
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+// DStarLite plans the cheapest path from a moving start to a fixed goal and
+// repairs it when edge costs change, instead of searching again from
+// scratch. It searches backwards from the goal and keeps its state between
+// calls to Plan. It is not safe for concurrent use
+type DStarLite[V comparable, E any, C any] struct {
+	graph     ReverseGraph[V, E, C]
+	heuristic Heuristic[V, C]
+	costs     costs[C]
+
+	start, goal V
+	// last is the start when km was last updated, km adds up the heuristic
+	// from every start to the next so queued keys stay valid as the start moves
+	last V
+	km   C
+
+	// g is the cost to the goal found so far, rhs the one step lookahead
+	// through the successors. Missing vertices cost infinitely much
+	g   map[V]C
+	rhs map[V]C
+
+	pq     PriorityQueue
+	queued map[V]*Item
+
+	// probe collects the Stats until the next Plan
+	probe *probe[V, E, C]
+
+	// err is set by the first update that failed, Plan reports it
+	err error
+}
+
+// dstarKey orders the queue of a DStarLite
+type dstarKey[V comparable, C any] struct {
+	vertex V
+	// primary is min(g, rhs) plus the heuristic from the start plus km,
+	// secondary is min(g, rhs)
+	primary, secondary C
+}
+
+// NewDStarLite creates a planner from start to goal over graph. heuristic
+// estimates the cost between two vertices, it must be consistent and may be
+// nil for none
+func NewDStarLite[V comparable, E any, C any](graph ReverseGraph[V, E, C], heuristic Heuristic[V, C], start, goal V) *DStarLite[V, E, C] {
+	d := &DStarLite[V, E, C]{
+		graph:     graph,
+		heuristic: heuristic,
+		costs:     costsOf[C](),
+		start:     start,
+		goal:      goal,
+		last:      start,
+		g:         map[V]C{},
+		rhs:       map[V]C{},
+		queued:    map[V]*Item{},
+		probe:     &probe[V, E, C]{},
+	}
+
+	var zero C
+	d.rhs[goal] = zero
+	d.update(goal)
+	return d
+}
+
+// NewDStarLiteByInterface plans over the Vertex and Edge interfaces, every
+// vertex must implement InVertex. Costs of edges are read when they are
+// needed, call Changed after changing them
+func NewDStarLiteByInterface(heuristic Heuristic[interface{}, int], start, goal InVertex) *DStarLite[Vertex, Edge, int] {
+	var h Heuristic[Vertex, int]
+	if heuristic != nil {
+		h = func(vertex, goal Vertex) int {
+			return heuristic(vertex, goal)
+		}
+	}
+	return NewDStarLite(NewReverseInterfaceGraph(), h, Vertex(start), Vertex(goal))
+}
+
+func (d *DStarLite[V, E, C]) estimate(vertex V) C {
+	if d.heuristic == nil {
+		var zero C
+		return zero
+	}
+	return d.heuristic(d.start, vertex)
+}
+
+// fail keeps the first error
+func (d *DStarLite[V, E, C]) fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+// key of vertex, ok is false when both g and rhs are infinite
+func (d *DStarLite[V, E, C]) key(vertex V) (dstarKey[V, C], bool) {
+	cost, ok := d.rhs[vertex]
+	if g, found := d.g[vertex]; found && (!ok || d.costs.less(g, cost)) {
+		cost, ok = g, true
+	}
+	if !ok {
+		return dstarKey[V, C]{}, false
+	}
+
+	primary, err := d.costs.sum(cost, d.estimate(vertex))
+	if err == nil {
+		primary, err = d.costs.sum(primary, d.km)
+	}
+	if err != nil {
+		d.fail(err)
+	}
+	return dstarKey[V, C]{vertex: vertex, primary: primary, secondary: cost}, true
+}
+
+func (d *DStarLite[V, E, C]) lessKey(value, other interface{}) bool {
+	a, b := value.(dstarKey[V, C]), other.(dstarKey[V, C])
+	if d.costs.less(a.primary, b.primary) {
+		return true
+	}
+	if d.costs.less(b.primary, a.primary) {
+		return false
+	}
+	return d.costs.less(a.secondary, b.secondary)
+}
+
+// consistent returns true if g and rhs of vertex are equal, or both infinite
+func (d *DStarLite[V, E, C]) consistent(vertex V) bool {
+	g, gFound := d.g[vertex]
+	rhs, rhsFound := d.rhs[vertex]
+	if gFound != rhsFound {
+		return false
+	}
+	return !gFound || !d.costs.less(g, rhs) && !d.costs.less(rhs, g)
+}
+
+// lookahead recomputes rhs of vertex from its successors
+func (d *DStarLite[V, E, C]) lookahead(vertex V) {
+	delete(d.rhs, vertex)
+	for _, edge := range d.graph.Edges(vertex) {
+		end := d.graph.EdgeEnd(edge)
+		g, found := d.g[end]
+		if !found {
+			continue
+		}
+		cost := d.graph.EdgeCost(edge)
+		if d.costs.negative(cost) {
+			d.fail(fmt.Errorf("%w: %v -> %v costs %v", ErrNegativeCost, vertex, end, cost))
+			continue
+		}
+		total, err := d.costs.sum(cost, g)
+		if err != nil {
+			d.fail(err)
+			continue
+		}
+		if rhs, found := d.rhs[vertex]; !found || d.costs.less(total, rhs) {
+			d.rhs[vertex] = total
+		}
+	}
+}
+
+// update queues vertex if it is inconsistent, and removes it otherwise
+func (d *DStarLite[V, E, C]) update(vertex V) {
+	if vertex != d.goal {
+		d.lookahead(vertex)
+	}
+
+	item, queued := d.queued[vertex]
+	if d.consistent(vertex) {
+		if queued {
+			d.pq.Remove(item)
+			delete(d.queued, vertex)
+		}
+		return
+	}
+
+	key, _ := d.key(vertex)
+	if queued {
+		d.pq.UpdateValue(item, key)
+		return
+	}
+	item = NewLessItem(key, d.lessKey)
+	heap.Push(&d.pq, item)
+	d.queued[vertex] = item
+	d.probe.pushed(&node[V, E, C]{vertex: vertex, totalCost: key.secondary, key: key.primary}, d.pq.Len())
+}
+
+// Move tells the planner the start is now vertex, usually the next vertex
+// of the last path planned
+func (d *DStarLite[V, E, C]) Move(vertex V) {
+	d.start = vertex
+	if d.heuristic == nil {
+		d.last = vertex
+		return
+	}
+
+	km, err := d.costs.sum(d.km, d.heuristic(d.last, vertex))
+	if err != nil {
+		d.fail(err)
+		return
+	}
+	d.km = km
+	d.last = vertex
+}
+
+// Changed tells the planner the cost of edges changed, or that they were
+// added to or removed from the graph
+func (d *DStarLite[V, E, C]) Changed(edges ...E) {
+	for _, edge := range edges {
+		d.update(d.graph.EdgeStart(edge))
+	}
+}
+
+// repair settles vertices until the cost from the start is known, or it cannot be reached
+func (d *DStarLite[V, E, C]) repair() {
+	for d.pq.Len() > 0 && d.err == nil {
+		top := d.pq[0].value.(dstarKey[V, C])
+		start, reachable := d.key(d.start)
+		if reachable && !d.lessKey(top, start) && d.consistent(d.start) {
+			return
+		}
+
+		current, _ := d.key(top.vertex)
+		if d.lessKey(top, current) {
+			d.pq.UpdateValue(d.queued[top.vertex], current)
+			continue
+		}
+
+		heap.Pop(&d.pq)
+		delete(d.queued, top.vertex)
+		n := &node[V, E, C]{vertex: top.vertex, totalCost: top.secondary, key: top.primary}
+		d.probe.popped(n)
+		d.probe.settled(n)
+
+		g, found := d.g[top.vertex]
+		if rhs, rhsFound := d.rhs[top.vertex]; rhsFound && (!found || d.costs.less(rhs, g)) {
+			// overconsistent, the lookahead is the cost
+			d.g[top.vertex] = rhs
+		} else {
+			// underconsistent, the cost went up and is found again
+			delete(d.g, top.vertex)
+			d.update(top.vertex)
+		}
+		for _, edge := range d.graph.InEdges(top.vertex) {
+			d.update(d.graph.EdgeStart(edge))
+		}
+	}
+}
+
+// Plan repairs the search after the changes since the last call and returns
+// the cheapest path from the start to the goal. Its Stats count the work
+// done since the last call only
+func (d *DStarLite[V, E, C]) Plan() *Path[V, E, C] {
+	d.repair()
+	stats := d.probe.stats
+	d.probe.stats = Stats{}
+	if d.err != nil {
+		return &Path[V, E, C]{Found: false, Stats: stats, Err: d.err}
+	}
+
+	if _, found := d.g[d.start]; !found {
+		return &Path[V, E, C]{Found: false, Stats: stats}
+	}
+
+	// follow the cheapest successors down to the goal, every vertex on the way is consistent
+	path := &Path[V, E, C]{
+		Found:    true,
+		Vertices: []V{d.start},
+		Edges:    []E{},
+		Stats:    stats,
+	}
+	visited := map[V]bool{d.start: true}
+	for vertex := d.start; vertex != d.goal; {
+		var best E
+		var bestCost, bestTotal C
+		found := false
+		for _, edge := range d.graph.Edges(vertex) {
+			g, reached := d.g[d.graph.EdgeEnd(edge)]
+			if !reached {
+				continue
+			}
+			cost := d.graph.EdgeCost(edge)
+			total, err := d.costs.sum(cost, g)
+			if err != nil {
+				return &Path[V, E, C]{Found: false, Stats: stats, Err: err}
+			}
+			if !found || d.costs.less(total, bestTotal) {
+				best, bestCost, bestTotal, found = edge, cost, total, true
+			}
+		}
+
+		if !found || visited[d.graph.EdgeEnd(best)] {
+			// only happens if the graph changed without Changed being called
+			return &Path[V, E, C]{Found: false, Stats: stats, Err: fmt.Errorf("planner out of date at %v", vertex)}
+		}
+		vertex = d.graph.EdgeEnd(best)
+		visited[vertex] = true
+
+		total, err := d.costs.sum(path.Cost, bestCost)
+		if err != nil {
+			return &Path[V, E, C]{Found: false, Stats: stats, Err: err}
+		}
+		path.Cost = total
+		path.Vertices = append(path.Vertices, vertex)
+		path.Edges = append(path.Edges, best)
+	}
+
+	return path
+}
@@ -0,0 +1,151 @@
+package shortest_path_test
+
+import (
+	"errors"
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/shortest_path/adjacency"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestLattice is a size x size lattice of two way edges with random costs
+func newTestLattice(size int) *adjacency.Graph[[2]int] {
+	r := rand.New(rand.NewSource(1))
+	g := adjacency.New[[2]int]()
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			g.AddVertex([2]int{x, y})
+			if x+1 < size {
+				g.AddUndirectedEdge([2]int{x, y}, [2]int{x + 1, y}, 1+r.Intn(9))
+			}
+			if y+1 < size {
+				g.AddUndirectedEdge([2]int{x, y}, [2]int{x, y + 1}, 1+r.Intn(9))
+			}
+		}
+	}
+	return g
+}
+
+func testLatticeVertex(g *adjacency.Graph[[2]int], x, y int) *adjacency.Vertex[[2]int] {
+	v, _ := g.Vertex([2]int{x, y})
+	return v
+}
+
+// testLatticeManhattan is consistent as every edge costs at least 1
+func testLatticeManhattan(vertex, goal interface{}) int {
+	a, b := vertex.(*adjacency.Vertex[[2]int]).ID(), goal.(*adjacency.Vertex[[2]int]).ID()
+	dx, dy := a[0]-b[0], a[1]-b[1]
+	if dx < 0 {
+		dx = -dx
+	}
+	if dy < 0 {
+		dy = -dy
+	}
+	return dx + dy
+}
+
+func Test_DStarLite_TestPlan(t *testing.T) {
+	g := newTestLattice(10)
+	start, goal := testLatticeVertex(g, 0, 0), testLatticeVertex(g, 9, 9)
+	uc := shortest_path.NewUniformCost(shortest_path.NewInterfaceGraph())
+
+	for _, heuristic := range []shortest_path.Heuristic[interface{}, int]{nil, testLatticeManhattan} {
+		planner := shortest_path.NewDStarLiteByInterface(heuristic, start, goal)
+		actual := planner.Plan()
+		expected := uc.Find(start, goal)
+		assert.True(t, actual.Found)
+		assert.Equal(t, expected.Cost, actual.Cost)
+		assert.Equal(t, shortest_path.Vertex(start), actual.Source())
+		assert.Equal(t, shortest_path.Vertex(goal), actual.Goal())
+		assert.Greater(t, actual.Stats.Expansions, 0)
+
+		// nothing changed, nothing to repair
+		again := planner.Plan()
+		assert.Equal(t, actual.Vertices, again.Vertices)
+		assert.Equal(t, 0, again.Stats.Expansions)
+	}
+}
+
+func Test_DStarLite_TestReplan(t *testing.T) {
+	g := newTestLattice(15)
+	goal := testLatticeVertex(g, 14, 14)
+	uc := shortest_path.NewUniformCost(shortest_path.NewInterfaceGraph())
+	planner := shortest_path.NewDStarLiteByInterface(testLatticeManhattan, testLatticeVertex(g, 0, 0), goal)
+
+	initial := planner.Plan()
+	assert.True(t, initial.Found)
+
+	r := rand.New(rand.NewSource(2))
+	path := initial
+	for len(path.Edges) > 1 {
+		// an edge further along the route turns out to be blocked or cheaper
+		edge := path.Edges[1+r.Intn(len(path.Edges)-1)].(*adjacency.Edge[[2]int])
+		if r.Intn(3) == 0 {
+			edge.SetCost(1)
+		} else {
+			edge.SetCost(edge.Cost() + 20)
+		}
+		planner.Changed(edge)
+
+		// as does one anywhere else
+		vertices := g.Vertices()
+		edges := vertices[r.Intn(len(vertices))].Edges()
+		other := edges[r.Intn(len(edges))].(*adjacency.Edge[[2]int])
+		other.SetCost(1 + r.Intn(30))
+		planner.Changed(other)
+
+		// and the robot moves on one vertex
+		planner.Move(path.Vertices[1])
+		path = planner.Plan()
+		expected := uc.Find(path.Vertices[0], goal)
+		assert.True(t, path.Found)
+		assert.Equal(t, expected.Cost, path.Cost)
+		assert.Less(t, path.Stats.Expansions, initial.Stats.Expansions)
+	}
+	assert.Equal(t, shortest_path.Vertex(goal), path.Goal())
+}
+
+func Test_DStarLite_TestRemovedEdges(t *testing.T) {
+	g := newTestLattice(5)
+	start, goal := testLatticeVertex(g, 0, 0), testLatticeVertex(g, 4, 4)
+	planner := shortest_path.NewDStarLiteByInterface(testLatticeManhattan, start, goal)
+	assert.True(t, planner.Plan().Found)
+
+	// cut the goal off
+	removed := goal.InEdges()
+	for _, edge := range removed {
+		g.RemoveEdge(edge.(*adjacency.Edge[[2]int]))
+	}
+	planner.Changed(removed...)
+	assert.False(t, planner.Plan().Found)
+
+	added := g.AddEdge([2]int{3, 4}, [2]int{4, 4}, 2)
+	planner.Changed(added)
+	actual := planner.Plan()
+	assert.True(t, actual.Found)
+	assert.Equal(t, shortest_path.Edge(added), actual.Edges[len(actual.Edges)-1])
+	assert.Equal(t, shortest_path.NewUniformCost(shortest_path.NewInterfaceGraph()).Find(start, goal).Cost, actual.Cost)
+}
+
+func Test_DStarLite_TestSameVertex(t *testing.T) {
+	g := newTestLattice(3)
+	v := testLatticeVertex(g, 1, 1)
+
+	actual := shortest_path.NewDStarLiteByInterface(nil, v, v).Plan()
+	assert.True(t, actual.Found)
+	assert.Equal(t, 0, actual.Cost)
+	assert.Equal(t, []shortest_path.Vertex{v}, actual.Vertices)
+	assert.Empty(t, actual.Edges)
+}
+
+func Test_DStarLite_TestNegativeCost(t *testing.T) {
+	g := newTestLattice(3)
+	edge, _ := g.AddUndirectedEdge([2]int{0, 0}, [2]int{2, 2}, 1)
+	edge.SetCost(-1)
+
+	actual := shortest_path.NewDStarLiteByInterface(nil, testLatticeVertex(g, 0, 0), testLatticeVertex(g, 2, 2)).Plan()
+	assert.False(t, actual.Found)
+	assert.True(t, errors.Is(actual.Err, shortest_path.ErrNegativeCost))
+}
@@ -0,0 +1,115 @@
+package shortest_path
+
+// Graph of vertices V connected by directed edges E costing C, C is int,
+// float64 or implements Cost
+type Graph[V comparable, E any, C any] interface {
+	Edges(vertex V) []E
+	EdgeEnd(edge E) V
+	EdgeCost(edge E) C
+}
+
+type funcGraph[V comparable, E any, C any] struct {
+	edges    func(V) []E
+	edgeEnd  func(E) V
+	edgeCost func(E) C
+}
+
+// NewFuncGraph creates a Graph from adjacency functions
+func NewFuncGraph[V comparable, E any, C any](edges func(V) []E, edgeEnd func(E) V, edgeCost func(E) C) Graph[V, E, C] {
+	return &funcGraph[V, E, C]{
+		edges:    edges,
+		edgeEnd:  edgeEnd,
+		edgeCost: edgeCost,
+	}
+}
+
+func (g *funcGraph[V, E, C]) Edges(vertex V) []E {
+	return g.edges(vertex)
+}
+
+func (g *funcGraph[V, E, C]) EdgeEnd(edge E) V {
+	return g.edgeEnd(edge)
+}
+
+func (g *funcGraph[V, E, C]) EdgeCost(edge E) C {
+	return g.edgeCost(edge)
+}
+
+type interfaceGraph struct {
+}
+
+// NewInterfaceGraph creates a Graph over the Vertex and Edge interfaces
+func NewInterfaceGraph() Graph[Vertex, Edge, int] {
+	return &interfaceGraph{}
+}
+
+func (g *interfaceGraph) Edges(vertex Vertex) []Edge {
+	return vertex.Edges()
+}
+
+func (g *interfaceGraph) EdgeEnd(edge Edge) Vertex {
+	return edge.To()
+}
+
+func (g *interfaceGraph) EdgeCost(edge Edge) int {
+	return edge.Cost()
+}
+
+// ReverseGraph is a Graph that can also walk its edges backwards
+type ReverseGraph[V comparable, E any, C any] interface {
+	Graph[V, E, C]
+	InEdges(vertex V) []E
+	EdgeStart(edge E) V
+}
+
+type reverseFuncGraph[V comparable, E any, C any] struct {
+	funcGraph[V, E, C]
+	inEdges   func(V) []E
+	edgeStart func(E) V
+}
+
+// NewReverseFuncGraph creates a ReverseGraph from adjacency and reverse adjacency functions
+func NewReverseFuncGraph[V comparable, E any, C any](edges func(V) []E, edgeEnd func(E) V, edgeCost func(E) C, inEdges func(V) []E, edgeStart func(E) V) ReverseGraph[V, E, C] {
+	return &reverseFuncGraph[V, E, C]{
+		funcGraph: funcGraph[V, E, C]{
+			edges:    edges,
+			edgeEnd:  edgeEnd,
+			edgeCost: edgeCost,
+		},
+		inEdges:   inEdges,
+		edgeStart: edgeStart,
+	}
+}
+
+func (g *reverseFuncGraph[V, E, C]) InEdges(vertex V) []E {
+	return g.inEdges(vertex)
+}
+
+func (g *reverseFuncGraph[V, E, C]) EdgeStart(edge E) V {
+	return g.edgeStart(edge)
+}
+
+// NewReverseInterfaceGraph creates a ReverseGraph over the Vertex and Edge
+// interfaces, the in-edges of vertices that do not implement InVertex are
+// unknown
+func NewReverseInterfaceGraph() ReverseGraph[Vertex, Edge, int] {
+	return &interfaceGraph{}
+}
+
+// InEdges returns nil if vertex does not implement InVertex
+func (g *interfaceGraph) InEdges(vertex Vertex) []Edge {
+	in, ok := vertex.(InVertex)
+	if !ok {
+		return nil
+	}
+	return in.InEdges()
+}
+
+func (g *interfaceGraph) hasInEdges(vertex Vertex) bool {
+	_, ok := vertex.(InVertex)
+	return ok
+}
+
+func (g *interfaceGraph) EdgeStart(edge Edge) Vertex {
+	return edge.From()
+}
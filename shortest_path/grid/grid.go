@@ -0,0 +1,231 @@
+// Package grid adapts 2D occupancy grids to the shortest_path searches and
+// adds Jump Point Search for grids of uniform cost
+package grid
+
+import (
+	"fmt"
+	"math"
+
+	"fatdes/go_algo/shortest_path"
+)
+
+// Point is a cell, X is the column and Y the row
+type Point struct {
+	X, Y int
+}
+
+func (p Point) String() string {
+	return fmt.Sprintf("(%d,%d)", p.X, p.Y)
+}
+
+// Move is a step between neighboring cells
+type Move struct {
+	From, To Point
+}
+
+func (m Move) diagonal() bool {
+	return m.From.X != m.To.X && m.From.Y != m.To.Y
+}
+
+// Connectivity is the number of neighbors of a cell
+type Connectivity int
+
+const (
+	Four  Connectivity = 4
+	Eight Connectivity = 8
+)
+
+// CornerRule says when a diagonal move may pass blocked cells
+type CornerRule int
+
+const (
+	// NoCornerCutting allows a diagonal move only when both cells beside it are free
+	NoCornerCutting CornerRule = iota
+	// NoSqueezing allows a diagonal move unless both cells beside it are blocked
+	NoSqueezing
+	// CornerCutting allows every diagonal move
+	CornerCutting
+)
+
+// Blocked is the cost of a cell that cannot be entered
+const Blocked = 0
+
+// Grid of cells with the cost to enter them, a diagonal move costs √2 times
+// the cost of the cell entered. Grid implements
+// shortest_path.ReverseGraph[Point, Move, float64]
+type Grid struct {
+	Width, Height int
+	Connectivity  Connectivity
+	Corners       CornerRule
+
+	costs []float64
+}
+
+// New creates a grid where every cell costs 1
+func New(width, height int, connectivity Connectivity) *Grid {
+	if width < 0 || height < 0 {
+		panic("grid size must not be negative")
+	}
+	if connectivity != Four && connectivity != Eight {
+		panic("connectivity must be Four or Eight")
+	}
+
+	g := &Grid{
+		Width:        width,
+		Height:       height,
+		Connectivity: connectivity,
+		costs:        make([]float64, width*height),
+	}
+	for i := range g.costs {
+		g.costs[i] = 1
+	}
+	return g
+}
+
+// In returns true if p is on the grid
+func (g *Grid) In(p Point) bool {
+	return p.X >= 0 && p.X < g.Width && p.Y >= 0 && p.Y < g.Height
+}
+
+// Cost to enter p, Blocked outside the grid
+func (g *Grid) Cost(p Point) float64 {
+	if !g.In(p) {
+		return Blocked
+	}
+	return g.costs[p.Y*g.Width+p.X]
+}
+
+// SetCost sets the cost to enter p, which must be positive or Blocked
+func (g *Grid) SetCost(p Point, cost float64) {
+	if !g.In(p) {
+		panic(fmt.Sprintf("%v is outside the grid", p))
+	}
+	if cost < 0 {
+		panic("cost must not be negative")
+	}
+	g.costs[p.Y*g.Width+p.X] = cost
+}
+
+// Block makes p impassable
+func (g *Grid) Block(p Point) {
+	g.SetCost(p, Blocked)
+}
+
+// Passable returns true if p can be entered
+func (g *Grid) Passable(p Point) bool {
+	return g.Cost(p) != Blocked
+}
+
+// directions of the neighbors, straight ones first
+var directions = []Point{
+	{1, 0}, {0, 1}, {-1, 0}, {0, -1},
+	{1, 1}, {-1, 1}, {-1, -1}, {1, -1},
+}
+
+// canMove returns true if a step by d from p is allowed, p itself is not checked
+func (g *Grid) canMove(p, d Point) bool {
+	to := Point{p.X + d.X, p.Y + d.Y}
+	if !g.Passable(to) {
+		return false
+	}
+	if d.X == 0 || d.Y == 0 {
+		return true
+	}
+	if g.Connectivity == Four {
+		return false
+	}
+
+	beside1 := g.Passable(Point{p.X + d.X, p.Y})
+	beside2 := g.Passable(Point{p.X, p.Y + d.Y})
+	switch g.Corners {
+	case NoCornerCutting:
+		return beside1 && beside2
+	case NoSqueezing:
+		return beside1 || beside2
+	default:
+		return true
+	}
+}
+
+func (g *Grid) neighbors() []Point {
+	if g.Connectivity == Four {
+		return directions[:4]
+	}
+	return directions
+}
+
+// Edges are the moves out of p
+func (g *Grid) Edges(p Point) []Move {
+	moves := make([]Move, 0, len(g.neighbors()))
+	if !g.Passable(p) {
+		return moves
+	}
+	for _, d := range g.neighbors() {
+		if g.canMove(p, d) {
+			moves = append(moves, Move{From: p, To: Point{p.X + d.X, p.Y + d.Y}})
+		}
+	}
+	return moves
+}
+
+func (g *Grid) EdgeEnd(m Move) Point {
+	return m.To
+}
+
+func (g *Grid) EdgeCost(m Move) float64 {
+	if m.diagonal() {
+		return math.Sqrt2 * g.Cost(m.To)
+	}
+	return g.Cost(m.To)
+}
+
+// InEdges are the moves into p, corner rules are symmetric so they are the
+// reverse of the moves out of p
+func (g *Grid) InEdges(p Point) []Move {
+	moves := g.Edges(p)
+	for i, m := range moves {
+		moves[i] = Move{From: m.To, To: m.From}
+	}
+	return moves
+}
+
+func (g *Grid) EdgeStart(m Move) Point {
+	return m.From
+}
+
+// minCost is the cheapest cell, 0 if every cell is blocked
+func (g *Grid) minCost() float64 {
+	min := 0.0
+	for _, cost := range g.costs {
+		if cost != Blocked && (min == 0 || cost < min) {
+			min = cost
+		}
+	}
+	return min
+}
+
+// distance is the cost of the shortest move sequence from -> to on an empty
+// grid where every cell costs 1
+func (g *Grid) distance(from, to Point) float64 {
+	dx := math.Abs(float64(from.X - to.X))
+	dy := math.Abs(float64(from.Y - to.Y))
+	if g.Connectivity == Four {
+		return dx + dy
+	}
+	return math.Max(dx, dy) + (math.Sqrt2-1)*math.Min(dx, dy)
+}
+
+// Heuristic returns the octile distance, or the Manhattan distance on a
+// 4-connected grid, scaled by the cheapest cell. Costs set after calling
+// Heuristic must not be cheaper than the cheapest cell was
+func (g *Grid) Heuristic() shortest_path.Heuristic[Point, float64] {
+	min := g.minCost()
+	return func(p, goal Point) float64 {
+		return min * g.distance(p, goal)
+	}
+}
+
+// NewAStar creates an A* search over the grid with its Heuristic
+func (g *Grid) NewAStar() *shortest_path.Search[Point, Move, float64] {
+	return shortest_path.NewAStar[Point, Move, float64](g, g.Heuristic())
+}
@@ -0,0 +1,113 @@
+package grid_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/shortest_path/grid"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ shortest_path.ReverseGraph[grid.Point, grid.Move, float64] = &grid.Grid{}
+
+func ends(moves []grid.Move) []grid.Point {
+	points := []grid.Point{}
+	for _, m := range moves {
+		points = append(points, m.To)
+	}
+	return points
+}
+
+func Test_Grid_TestEdges(t *testing.T) {
+	g := grid.New(3, 3, grid.Four)
+	assert.ElementsMatch(t, []grid.Point{{2, 1}, {1, 2}, {0, 1}, {1, 0}}, ends(g.Edges(grid.Point{1, 1})))
+	assert.ElementsMatch(t, []grid.Point{{1, 0}, {0, 1}}, ends(g.Edges(grid.Point{0, 0})))
+
+	g.Block(grid.Point{1, 1})
+	assert.Empty(t, g.Edges(grid.Point{1, 1}))
+	assert.ElementsMatch(t, []grid.Point{{0, 0}, {2, 0}}, ends(g.Edges(grid.Point{1, 0})))
+	assert.False(t, g.Passable(grid.Point{-1, 0}))
+}
+
+func Test_Grid_TestCornerRules(t *testing.T) {
+	// .#
+	// ..
+	g := grid.New(2, 2, grid.Eight)
+	g.Block(grid.Point{1, 0})
+	from := grid.Point{0, 0}
+	diagonal := grid.Point{1, 1}
+
+	g.Corners = grid.NoCornerCutting
+	assert.NotContains(t, ends(g.Edges(from)), diagonal)
+	g.Corners = grid.NoSqueezing
+	assert.Contains(t, ends(g.Edges(from)), diagonal)
+
+	g.Block(grid.Point{0, 1})
+	assert.NotContains(t, ends(g.Edges(from)), diagonal)
+	g.Corners = grid.CornerCutting
+	assert.Contains(t, ends(g.Edges(from)), diagonal)
+	assert.Contains(t, g.InEdges(diagonal), grid.Move{From: from, To: diagonal})
+}
+
+func Test_Grid_TestCosts(t *testing.T) {
+	g := grid.New(3, 1, grid.Four)
+	g.SetCost(grid.Point{1, 0}, 5)
+	assert.Equal(t, 5.0, g.EdgeCost(grid.Move{From: grid.Point{0, 0}, To: grid.Point{1, 0}}))
+	assert.Equal(t, 1.0, g.EdgeCost(grid.Move{From: grid.Point{1, 0}, To: grid.Point{0, 0}}))
+
+	g8 := grid.New(2, 2, grid.Eight)
+	g8.SetCost(grid.Point{1, 1}, 2)
+	assert.InDelta(t, 2*math.Sqrt2, g8.EdgeCost(grid.Move{From: grid.Point{0, 0}, To: grid.Point{1, 1}}), 1e-9)
+
+	assert.Panics(t, func() { g.SetCost(grid.Point{3, 0}, 1) })
+	assert.Panics(t, func() { g.SetCost(grid.Point{0, 0}, -1) })
+	assert.Panics(t, func() { grid.New(1, 1, 6) })
+}
+
+func Test_Grid_TestSearch(t *testing.T) {
+	g, marks, err := grid.ParseMaze(`
+S.#......
+..#.###..
+..#...#..
+..###.#..
+......#.G
+`, grid.Four)
+	assert.NoError(t, err)
+	from, to := marks['S'], marks['G']
+
+	uc := shortest_path.NewUniformCost[grid.Point, grid.Move](g).Find(from, to)
+	assert.True(t, uc.Found)
+	assert.Equal(t, 24.0, uc.Cost)
+	assert.Equal(t, from, uc.Vertices[0])
+	assert.Equal(t, to, uc.Vertices[len(uc.Vertices)-1])
+
+	astar := g.NewAStar().Find(from, to)
+	assert.Equal(t, uc.Cost, astar.Cost)
+	assert.LessOrEqual(t, astar.Stats.Expansions, uc.Stats.Expansions)
+
+	bi := shortest_path.NewBidirectional[grid.Point, grid.Move](g).Find(from, to)
+	assert.Equal(t, uc.Cost, bi.Cost)
+
+	g.Block(grid.Point{6, 0})
+	assert.False(t, g.NewAStar().Find(from, to).Found)
+}
+
+func Test_Grid_TestHeuristicAdmissible(t *testing.T) {
+	g, marks, _ := grid.ParseMaze(`
+S.3..
+.#2#.
+.#5#.
+..9.G
+`, grid.Eight)
+	samples := []grid.Point{}
+	for y := 0; y < g.Height; y++ {
+		for x := 0; x < g.Width; x++ {
+			samples = append(samples, grid.Point{x, y})
+		}
+	}
+
+	exact := shortest_path.NewUniformCost[grid.Point, grid.Move](g)
+	assert.Empty(t, shortest_path.CheckAdmissible(exact, g.Heuristic(), samples, marks['G']))
+	assert.InDelta(t, exact.Find(marks['S'], marks['G']).Cost, g.NewAStar().Find(marks['S'], marks['G']).Cost, 1e-9)
+}
@@ -0,0 +1,212 @@
+package grid
+
+import (
+	"container/heap"
+	"errors"
+
+	"fatdes/go_algo/shortest_path"
+)
+
+// ErrUnsupported is reported by JumpPointSearch on grids it cannot search
+var ErrUnsupported = errors.New("jump point search needs an 8-connected grid of uniform cost without corner cutting")
+
+// jumpNode is a jump point reached from parent
+type jumpNode struct {
+	point  Point
+	cost   float64
+	key    float64
+	parent *jumpNode
+}
+
+func lessJumpNode(value, other interface{}) bool {
+	return value.(*jumpNode).key < other.(*jumpNode).key
+}
+
+func sign(v int) int {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+	return 0
+}
+
+// uniformCost returns the cost of every passable cell, false if they differ
+func (g *Grid) uniformCost() (float64, bool) {
+	cost := g.minCost()
+	for _, c := range g.costs {
+		if c != Blocked && c != cost {
+			return 0, false
+		}
+	}
+	return cost, true
+}
+
+// forced returns true if p, entered straight along d, has a neighbor that
+// can only be reached optimally through p
+func (g *Grid) forced(p, d Point) bool {
+	if d.X != 0 {
+		return g.Passable(Point{p.X, p.Y - 1}) && !g.Passable(Point{p.X - d.X, p.Y - 1}) ||
+			g.Passable(Point{p.X, p.Y + 1}) && !g.Passable(Point{p.X - d.X, p.Y + 1})
+	}
+	return g.Passable(Point{p.X - 1, p.Y}) && !g.Passable(Point{p.X - 1, p.Y - d.Y}) ||
+		g.Passable(Point{p.X + 1, p.Y}) && !g.Passable(Point{p.X + 1, p.Y - d.Y})
+}
+
+// jump moves from p along d until it reaches goal or a jump point, it
+// returns false if it runs into a wall first
+func (g *Grid) jump(p, d, goal Point) (Point, bool) {
+	for {
+		if !g.Passable(p) {
+			return p, false
+		}
+		if p == goal {
+			return p, true
+		}
+
+		if d.X != 0 && d.Y != 0 {
+			if _, found := g.jump(Point{p.X + d.X, p.Y}, Point{d.X, 0}, goal); found {
+				return p, true
+			}
+			if _, found := g.jump(Point{p.X, p.Y + d.Y}, Point{0, d.Y}, goal); found {
+				return p, true
+			}
+		} else if g.forced(p, d) {
+			return p, true
+		}
+
+		if !g.canMove(p, d) {
+			return p, false
+		}
+		p = Point{p.X + d.X, p.Y + d.Y}
+	}
+}
+
+// successors returns the directions worth following from n, pruning the
+// neighbors reached at least as cheaply without passing n
+func (g *Grid) successors(n *jumpNode) []Point {
+	if n.parent == nil {
+		result := []Point{}
+		for _, d := range directions {
+			if g.canMove(n.point, d) {
+				result = append(result, d)
+			}
+		}
+		return result
+	}
+
+	p := n.point
+	d := Point{sign(p.X - n.parent.point.X), sign(p.Y - n.parent.point.Y)}
+	candidates := []Point{d}
+	switch {
+	case d.X != 0 && d.Y != 0:
+		candidates = append(candidates, Point{d.X, 0}, Point{0, d.Y})
+	case d.X != 0:
+		candidates = append(candidates, Point{0, 1}, Point{0, -1}, Point{d.X, 1}, Point{d.X, -1})
+	default:
+		candidates = append(candidates, Point{1, 0}, Point{-1, 0}, Point{1, d.Y}, Point{-1, d.Y})
+	}
+
+	result := []Point{}
+	for _, c := range candidates {
+		if g.canMove(p, c) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// JumpPointSearch finds the cheapest path from -> to like A*, but only
+// queues the jump points where the path may turn. The path holds every cell
+func (g *Grid) JumpPointSearch(from, to Point) *shortest_path.Path[Point, Move, float64] {
+	cost, uniform := g.uniformCost()
+	if g.Connectivity != Eight || g.Corners != NoCornerCutting || !uniform {
+		return &shortest_path.Path[Point, Move, float64]{Found: false, Err: ErrUnsupported}
+	}
+	if !g.Passable(from) || !g.Passable(to) {
+		return &shortest_path.Path[Point, Move, float64]{Found: false}
+	}
+
+	estimate := func(p Point) float64 {
+		return cost * g.distance(p, to)
+	}
+
+	stats := shortest_path.Stats{Pushes: 1, PeakQueue: 1}
+	start := &jumpNode{point: from, key: estimate(from)}
+	pq := shortest_path.PriorityQueue{shortest_path.NewLessItem(start, lessJumpNode)}
+	heap.Init(&pq)
+	queued := map[Point]*shortest_path.Item{from: pq[0]}
+	closed := map[Point]bool{}
+
+	for pq.Len() > 0 {
+		n := heap.Pop(&pq).(*shortest_path.Item).Value().(*jumpNode)
+		delete(queued, n.point)
+		closed[n.point] = true
+
+		if n.point == to {
+			path := g.walk(n)
+			path.Stats = stats
+			return path
+		}
+		stats.Expansions++
+
+		for _, d := range g.successors(n) {
+			jp, found := g.jump(Point{n.point.X + d.X, n.point.Y + d.Y}, d, to)
+			if !found || closed[jp] {
+				continue
+			}
+
+			next := &jumpNode{
+				point:  jp,
+				cost:   n.cost + cost*g.distance(n.point, jp),
+				parent: n,
+			}
+			next.key = next.cost + estimate(jp)
+
+			if item, found := queued[jp]; found {
+				if next.cost < item.Value().(*jumpNode).cost {
+					pq.UpdateValue(item, next)
+				}
+				continue
+			}
+
+			item := shortest_path.NewLessItem(next, lessJumpNode)
+			heap.Push(&pq, item)
+			queued[jp] = item
+			stats.Pushes++
+			if pq.Len() > stats.PeakQueue {
+				stats.PeakQueue = pq.Len()
+			}
+		}
+	}
+
+	return &shortest_path.Path[Point, Move, float64]{Found: false, Stats: stats}
+}
+
+// walk fills in the cells between the jump points back from n, which lie
+// on straight or diagonal lines
+func (g *Grid) walk(n *jumpNode) *shortest_path.Path[Point, Move, float64] {
+	jumpPoints := []Point{}
+	for current := n; current != nil; current = current.parent {
+		jumpPoints = append(jumpPoints, current.point)
+	}
+
+	path := &shortest_path.Path[Point, Move, float64]{
+		Found:    true,
+		Vertices: []Point{jumpPoints[len(jumpPoints)-1]},
+		Edges:    []Move{},
+	}
+	for i := len(jumpPoints) - 1; i > 0; i-- {
+		p, end := jumpPoints[i], jumpPoints[i-1]
+		d := Point{sign(end.X - p.X), sign(end.Y - p.Y)}
+		for p != end {
+			move := Move{From: p, To: Point{p.X + d.X, p.Y + d.Y}}
+			path.Cost += g.EdgeCost(move)
+			path.Edges = append(path.Edges, move)
+			path.Vertices = append(path.Vertices, move.To)
+			p = move.To
+		}
+	}
+	return path
+}
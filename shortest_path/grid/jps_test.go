@@ -0,0 +1,96 @@
+package grid_test
+
+import (
+	"fatdes/go_algo/shortest_path/grid"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestRandomGrid blocks cells at random with a fixed seed
+func newTestRandomGrid(seed int64, width, height int, density float64) *grid.Grid {
+	r := rand.New(rand.NewSource(seed))
+	g := grid.New(width, height, grid.Eight)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if r.Float64() < density {
+				g.Block(grid.Point{x, y})
+			}
+		}
+	}
+	return g
+}
+
+func Test_JPS_TestOpenGrid(t *testing.T) {
+	g := grid.New(10, 10, grid.Eight)
+
+	actual := g.JumpPointSearch(grid.Point{0, 0}, grid.Point{9, 4})
+	assert.True(t, actual.Found)
+	assert.InDelta(t, 5+4*math.Sqrt2, actual.Cost, 1e-9)
+	assert.Len(t, actual.Vertices, 10)
+	assert.Len(t, actual.Edges, 9)
+	for i, m := range actual.Edges {
+		assert.Equal(t, actual.Vertices[i], m.From)
+		assert.Equal(t, actual.Vertices[i+1], m.To)
+	}
+
+	same := g.JumpPointSearch(grid.Point{3, 3}, grid.Point{3, 3})
+	assert.True(t, same.Found)
+	assert.Equal(t, []grid.Point{{3, 3}}, same.Vertices)
+}
+
+func Test_JPS_TestSameCostAsAStar(t *testing.T) {
+	for seed := int64(1); seed <= 30; seed++ {
+		g := newTestRandomGrid(seed, 30, 20, 0.3)
+		from, to := grid.Point{0, 0}, grid.Point{29, 19}
+		g.SetCost(from, 1)
+		g.SetCost(to, 1)
+
+		expected := g.NewAStar().Find(from, to)
+		actual := g.JumpPointSearch(from, to)
+		assert.Equal(t, expected.Found, actual.Found, seed)
+		assert.InDelta(t, expected.Cost, actual.Cost, 1e-9, seed)
+		if actual.Found {
+			assert.LessOrEqual(t, actual.Stats.Pushes, expected.Stats.Pushes, seed)
+			for _, p := range actual.Vertices {
+				assert.True(t, g.Passable(p), seed)
+			}
+		}
+	}
+}
+
+func Test_JPS_TestMaze(t *testing.T) {
+	g, marks, _ := grid.ParseMaze(`
+S.......#......
+.######.#.####.
+.#....#.#....#.
+.#.##.#.####.#.
+...#..#......#G
+`, grid.Eight)
+
+	expected := g.NewAStar().Find(marks['S'], marks['G'])
+	actual := g.JumpPointSearch(marks['S'], marks['G'])
+	assert.True(t, actual.Found)
+	assert.InDelta(t, expected.Cost, actual.Cost, 1e-9)
+}
+
+func Test_JPS_TestUnsupported(t *testing.T) {
+	g := grid.New(3, 3, grid.Four)
+	assert.ErrorIs(t, g.JumpPointSearch(grid.Point{0, 0}, grid.Point{2, 2}).Err, grid.ErrUnsupported)
+
+	g = grid.New(3, 3, grid.Eight)
+	g.Corners = grid.CornerCutting
+	assert.ErrorIs(t, g.JumpPointSearch(grid.Point{0, 0}, grid.Point{2, 2}).Err, grid.ErrUnsupported)
+
+	g = grid.New(3, 3, grid.Eight)
+	g.SetCost(grid.Point{1, 1}, 2)
+	assert.ErrorIs(t, g.JumpPointSearch(grid.Point{0, 0}, grid.Point{2, 2}).Err, grid.ErrUnsupported)
+
+	g = grid.New(3, 3, grid.Eight)
+	g.Block(grid.Point{2, 2})
+	actual := g.JumpPointSearch(grid.Point{0, 0}, grid.Point{2, 2})
+	assert.False(t, actual.Found)
+	assert.NoError(t, actual.Err)
+}
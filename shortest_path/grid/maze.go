@@ -0,0 +1,87 @@
+package grid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseMaze reads an ASCII map, one row per line: '#' is a wall, '.' or ' '
+// a cell costing 1 and '1' to '9' a cell of that cost. Any other letter is a
+// cell costing 1 whose position is returned in marks, like S and G for the
+// start and goal. Leading and trailing blank lines are dropped, and rows must
+// have the same width
+func ParseMaze(maze string, connectivity Connectivity) (*Grid, map[rune]Point, error) {
+	lines := strings.Split(strings.ReplaceAll(maze, "\r\n", "\n"), "\n")
+	first := 0
+	for first < len(lines) && strings.TrimSpace(lines[first]) == "" {
+		first++
+	}
+	last := len(lines)
+	for last > first && strings.TrimSpace(lines[last-1]) == "" {
+		last--
+	}
+	rows := lines[first:last]
+
+	width := 0
+	if len(rows) > 0 {
+		width = len([]rune(rows[0]))
+	}
+	g := New(width, len(rows), connectivity)
+	marks := map[rune]Point{}
+
+	for y, row := range rows {
+		cells := []rune(row)
+		if len(cells) != width {
+			return nil, nil, fmt.Errorf("line %d: row is %d wide, want %d", first+y+1, len(cells), width)
+		}
+		for x, c := range cells {
+			p := Point{x, y}
+			switch {
+			case c == '#':
+				g.Block(p)
+			case c == '.' || c == ' ':
+			case c >= '1' && c <= '9':
+				g.SetCost(p, float64(c-'0'))
+			case c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z':
+				if _, found := marks[c]; found {
+					return nil, nil, fmt.Errorf("line %d: mark %c is used twice", first+y+1, c)
+				}
+				marks[c] = p
+			default:
+				return nil, nil, fmt.Errorf("line %d: unknown cell %q", first+y+1, c)
+			}
+		}
+	}
+
+	return g, marks, nil
+}
+
+// Draw renders the grid like ParseMaze reads it, with the cells of path as '*'
+func (g *Grid) Draw(path []Point) string {
+	onPath := map[Point]bool{}
+	for _, p := range path {
+		onPath[p] = true
+	}
+
+	b := strings.Builder{}
+	for y := 0; y < g.Height; y++ {
+		for x := 0; x < g.Width; x++ {
+			p := Point{x, y}
+			cost := g.Cost(p)
+			switch {
+			case onPath[p]:
+				b.WriteByte('*')
+			case cost == Blocked:
+				b.WriteByte('#')
+			case cost == 1:
+				b.WriteByte('.')
+			case cost == float64(int(cost)) && cost > 1 && cost <= 9:
+				b.WriteByte(byte('0' + int(cost)))
+			default:
+				b.WriteByte('?')
+			}
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
@@ -0,0 +1,32 @@
+package grid_test
+
+import (
+	"fatdes/go_algo/shortest_path/grid"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Maze_TestParse(t *testing.T) {
+	g, marks, err := grid.ParseMaze("\n\nS.#\n 5G\n\n", grid.Eight)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, g.Width)
+	assert.Equal(t, 2, g.Height)
+	assert.Equal(t, map[rune]grid.Point{'S': {0, 0}, 'G': {2, 1}}, marks)
+	assert.False(t, g.Passable(grid.Point{2, 0}))
+	assert.Equal(t, 1.0, g.Cost(grid.Point{0, 1}))
+	assert.Equal(t, 5.0, g.Cost(grid.Point{1, 1}))
+
+	assert.Equal(t, "..#\n.5*\n", g.Draw([]grid.Point{{2, 1}}))
+}
+
+func Test_Maze_TestErrors(t *testing.T) {
+	_, _, err := grid.ParseMaze("\n...\n..\n", grid.Four)
+	assert.EqualError(t, err, "line 3: row is 2 wide, want 3")
+
+	_, _, err = grid.ParseMaze("S.S", grid.Four)
+	assert.EqualError(t, err, "line 1: mark S is used twice")
+
+	_, _, err = grid.ParseMaze("..\n.@", grid.Four)
+	assert.EqualError(t, err, `line 2: unknown cell '@'`)
+}
@@ -0,0 +1,80 @@
+package shortest_path
+
+import (
+	"fmt"
+	"math"
+)
+
+// earthRadius in metres, used by Haversine
+const earthRadius = 6371000
+
+// Manhattan distance between the x, y positions of vertex and goal
+func Manhattan[C Numeric, V comparable](position func(vertex V) (float64, float64)) Heuristic[V, C] {
+	return func(vertex, goal V) C {
+		x1, y1 := position(vertex)
+		x2, y2 := position(goal)
+		return C(math.Abs(x1-x2) + math.Abs(y1-y2))
+	}
+}
+
+// Euclidean straight line distance between the x, y positions of vertex and goal
+func Euclidean[C Numeric, V comparable](position func(vertex V) (float64, float64)) Heuristic[V, C] {
+	return func(vertex, goal V) C {
+		x1, y1 := position(vertex)
+		x2, y2 := position(goal)
+		return C(math.Hypot(x1-x2, y1-y2))
+	}
+}
+
+// Haversine great circle distance in metres between the latitude, longitude
+// positions (in degrees) of vertex and goal
+func Haversine[C Numeric, V comparable](position func(vertex V) (float64, float64)) Heuristic[V, C] {
+	return func(vertex, goal V) C {
+		lat1, lon1 := position(vertex)
+		lat2, lon2 := position(goal)
+
+		phi1 := lat1 * math.Pi / 180
+		phi2 := lat2 * math.Pi / 180
+		dPhi := (lat2 - lat1) * math.Pi / 180
+		dLambda := (lon2 - lon1) * math.Pi / 180
+
+		a := math.Sin(dPhi/2)*math.Sin(dPhi/2) +
+			math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+		return C(2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a))))
+	}
+}
+
+// Inadmissible is a sample where the heuristic overestimates the actual cost
+type Inadmissible[V comparable, C any] struct {
+	Vertex   V
+	Goal     V
+	Estimate C
+	Cost     C
+}
+
+func (i *Inadmissible[V, C]) String() string {
+	return fmt.Sprintf("heuristic overestimates %v -> %v: estimate %v > cost %v", i.Vertex, i.Goal, i.Estimate, i.Cost)
+}
+
+// CheckAdmissible compares the heuristic against the actual cost found by
+// exact from every sample vertex to goal, samples that cannot reach goal are skipped
+func CheckAdmissible[V comparable, E any, C any](exact *Search[V, E, C], heuristic Heuristic[V, C], samples []V, goal V) []*Inadmissible[V, C] {
+	warnings := []*Inadmissible[V, C]{}
+	for _, vertex := range samples {
+		result := exact.Find(vertex, goal)
+		if !result.Found {
+			continue
+		}
+
+		if estimate := heuristic(vertex, goal); exact.costs.less(result.Cost, estimate) {
+			warnings = append(warnings, &Inadmissible[V, C]{
+				Vertex:   vertex,
+				Goal:     goal,
+				Estimate: estimate,
+				Cost:     result.Cost,
+			})
+		}
+	}
+
+	return warnings
+}
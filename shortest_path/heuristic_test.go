@@ -0,0 +1,49 @@
+package shortest_path_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Heuristic_TestDistances(t *testing.T) {
+	positions := map[interface{}][2]float64{
+		"origin": {0, 0},
+		"corner": {3, 4},
+		"london": {51.5074, -0.1278},
+		"paris":  {48.8566, 2.3522},
+	}
+	position := func(vertex interface{}) (float64, float64) {
+		p := positions[vertex]
+		return p[0], p[1]
+	}
+
+	assert.Equal(t, 7, shortest_path.Manhattan[int](position)("origin", "corner"))
+	assert.Equal(t, 5, shortest_path.Euclidean[int](position)("origin", "corner"))
+	assert.Equal(t, 0, shortest_path.Euclidean[int](position)("corner", "corner"))
+	assert.InDelta(t, 343500, shortest_path.Haversine[int](position)("london", "paris"), 1000)
+}
+
+func Test_Heuristic_TestCheckAdmissible(t *testing.T) {
+	graph := &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+	graph.buildTestByFuncGraph()
+
+	uc := shortest_path.NewUniformCost(shortest_path.NewFuncGraph(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost))
+
+	admissible := func(vertex, goal interface{}) int {
+		return 0
+	}
+	assert.Empty(t, shortest_path.CheckAdmissible(uc, admissible, []interface{}{"a", "d", "f"}, "g"))
+
+	// a -> g costs 8, f -> g costs 3
+	overestimate := func(vertex, goal interface{}) int {
+		return 5
+	}
+	warnings := shortest_path.CheckAdmissible(uc, overestimate, []interface{}{"a", "f", "h"}, "g")
+	assert.Len(t, warnings, 1)
+	assert.Equal(t, "f", warnings[0].Vertex)
+	assert.Equal(t, 5, warnings[0].Estimate)
+	assert.Equal(t, 3, warnings[0].Cost)
+	assert.Equal(t, "heuristic overestimates f -> g: estimate 5 > cost 3", warnings[0].String())
+}
@@ -0,0 +1,87 @@
+// Package testgraph builds the loader graphs shared by the tests and
+// benchmarks of the shortest_path packages
+package testgraph
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"fatdes/go_algo/shortest_path/loader"
+)
+
+// Small is the graph of the by-func and by-interface tests with an extra
+// vertex h leading into a, the cheapest path a -> g costs 8
+func Small() *loader.Graph {
+	graph, err := loader.ReadCSV(strings.NewReader(`a,d,3
+a,b,5
+b,c,1
+c,e,6
+c,g,8
+d,e,2
+d,f,2
+e,b,4
+f,g,3
+g,e,4
+h,a,1
+`))
+	if err != nil {
+		panic(err)
+	}
+	return graph
+}
+
+// Random is a random graph with a fixed seed, its vertices are named 0 to
+// vertices-1 in order and have degree out-edges each
+func Random(vertices, degree int) *loader.Graph {
+	r := rand.New(rand.NewSource(1))
+	graph := loader.NewGraph()
+	for v := 0; v < vertices; v++ {
+		graph.AddVertex(strconv.Itoa(v))
+	}
+	for v := 0; v < vertices; v++ {
+		for i := 0; i < degree; i++ {
+			graph.AddEdge(strconv.Itoa(v), strconv.Itoa(r.Intn(vertices)), r.Intn(100))
+		}
+	}
+	return graph
+}
+
+// Lattice is a square lattice of two way roads with random costs and a
+// fixed seed, vertices are named x,y
+func Lattice(size int) *loader.Graph {
+	r := rand.New(rand.NewSource(1))
+	graph := loader.NewGraph()
+	id := func(x, y int) string {
+		return strconv.Itoa(x) + "," + strconv.Itoa(y)
+	}
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			graph.AddVertex(id(x, y))
+			if x+1 < size {
+				cost := 1 + r.Intn(100)
+				graph.AddEdge(id(x, y), id(x+1, y), cost)
+				graph.AddEdge(id(x+1, y), id(x, y), cost)
+			}
+			if y+1 < size {
+				cost := 1 + r.Intn(100)
+				graph.AddEdge(id(x, y), id(x, y+1), cost)
+				graph.AddEdge(id(x, y+1), id(x, y), cost)
+			}
+		}
+	}
+	return graph
+}
+
+// Query returns the indexes of the endpoints of the i-th query among n
+// vertices, consecutive queries are spread over the whole graph
+func Query(i, n int) (from, to int) {
+	return i % n, (i*7919 + 1) % n
+}
+
+// QueryVertices returns the endpoints of the i-th Query among the vertices
+// of graph
+func QueryVertices(graph *loader.Graph, i int) (from, to string) {
+	f, t := Query(i, len(graph.Vertices))
+	return graph.Vertices[f], graph.Vertices[t]
+}
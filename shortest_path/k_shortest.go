@@ -0,0 +1,160 @@
+package shortest_path
+
+import (
+	"container/heap"
+)
+
+// filteredGraph hides removed vertices and edges of a Graph
+type filteredGraph[V comparable, E any, C any] struct {
+	Graph[V, E, C]
+
+	removedVertices map[V]bool
+	removedEdges    []E
+}
+
+func (g *filteredGraph[V, E, C]) Edges(vertex V) []E {
+	if g.removedVertices[vertex] {
+		return nil
+	}
+
+	edges := []E{}
+	for _, edge := range g.Graph.Edges(vertex) {
+		if !g.removedVertices[g.Graph.EdgeEnd(edge)] && !containsEdge(g.removedEdges, edge) {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
+// sameEdge compares edges by value, E must be comparable at runtime
+func sameEdge[E any](e1, e2 E) bool {
+	return any(e1) == any(e2)
+}
+
+func containsEdge[E any](edges []E, edge E) bool {
+	for _, e := range edges {
+		if sameEdge(e, edge) {
+			return true
+		}
+	}
+	return false
+}
+
+// samePrefix returns true if the first n edges of both paths are the same
+func samePrefix[V comparable, E any, C any](p1, p2 *Path[V, E, C], n int) bool {
+	if len(p1.Edges) < n || len(p2.Edges) < n || p1.Vertices[0] != p2.Vertices[0] {
+		return false
+	}
+
+	for i := 0; i < n; i++ {
+		if p1.Vertices[i+1] != p2.Vertices[i+1] || !sameEdge(p1.Edges[i], p2.Edges[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func samePath[V comparable, E any, C any](p1, p2 *Path[V, E, C]) bool {
+	return len(p1.Edges) == len(p2.Edges) && samePrefix(p1, p2, len(p1.Edges))
+}
+
+func containsPath[V comparable, E any, C any](paths []*Path[V, E, C], path *Path[V, E, C]) bool {
+	for _, p := range paths {
+		if samePath(p, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// FindK returns up to k loopless paths from -> to, cheapest first, using Yen's
+// algorithm. Edges are compared by value so E must be comparable at runtime.
+// If a search fails the last path holds the error
+func (s *Search[V, E, C]) FindK(from, to V, k int) []*Path[V, E, C] {
+	paths := []*Path[V, E, C]{}
+	if k <= 0 {
+		return paths
+	}
+
+	shortest := s.Find(from, to)
+	if shortest.Err != nil {
+		return append(paths, shortest)
+	}
+	if !shortest.Found {
+		return paths
+	}
+	paths = append(paths, shortest)
+
+	lessPath := func(value, other interface{}) bool {
+		return s.costs.less(value.(*Path[V, E, C]).Cost, other.(*Path[V, E, C]).Cost)
+	}
+	candidates := make(PriorityQueue, 0)
+	seen := []*Path[V, E, C]{}
+
+	for len(paths) < k {
+		previous := paths[len(paths)-1]
+
+		for i := 0; i < len(previous.Edges); i++ {
+			spur := previous.Vertices[i]
+
+			filtered := &filteredGraph[V, E, C]{
+				Graph:           s.graph,
+				removedVertices: map[V]bool{},
+			}
+			// remove the next edge of every path sharing this root so the spur differs
+			for _, p := range paths {
+				if samePrefix(p, previous, i) {
+					filtered.removedEdges = append(filtered.removedEdges, p.Edges[i])
+				}
+			}
+			// remove the root vertices so the spur path is loopless
+			for _, v := range previous.Vertices[:i] {
+				filtered.removedVertices[v] = true
+			}
+
+			spurSearch := *s
+			spurSearch.graph = filtered
+			spurSearch.reverse = nil
+			spurPath := spurSearch.Find(spur, to)
+			if spurPath.Err != nil {
+				return append(paths, spurPath)
+			}
+			if !spurPath.Found {
+				continue
+			}
+
+			var cost C
+			for _, edge := range previous.Edges[:i] {
+				var err error
+				if cost, err = s.costs.sum(cost, s.graph.EdgeCost(edge)); err != nil {
+					return append(paths, &Path[V, E, C]{Found: false, Err: err})
+				}
+			}
+			cost, err := s.costs.sum(cost, spurPath.Cost)
+			if err != nil {
+				return append(paths, &Path[V, E, C]{Found: false, Err: err})
+			}
+
+			candidate := &Path[V, E, C]{
+				Found: true,
+
+				Cost:     cost,
+				Vertices: append(append([]V{}, previous.Vertices[:i]...), spurPath.Vertices...),
+				Edges:    append(append([]E{}, previous.Edges[:i]...), spurPath.Edges...),
+			}
+			if containsPath(seen, candidate) {
+				continue
+			}
+			seen = append(seen, candidate)
+
+			heap.Push(&candidates, NewLessItem(candidate, lessPath))
+		}
+
+		if candidates.Len() == 0 {
+			break
+		}
+		paths = append(paths, heap.Pop(&candidates).(*Item).value.(*Path[V, E, C]))
+	}
+
+	return paths
+}
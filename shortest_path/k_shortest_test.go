@@ -0,0 +1,77 @@
+package shortest_path_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// buildTestYenGraph is the example graph of Yen's algorithm
+func buildTestYenGraph() *testTypedGraph {
+	graph := &testTypedGraph{edges: map[string][]*testTypedEdge{}}
+	for _, e := range []testTypedEdge{
+		{"c", "d", 3}, {"c", "e", 2}, {"d", "f", 4}, {"e", "d", 1}, {"e", "f", 2},
+		{"e", "g", 3}, {"f", "g", 2}, {"f", "h", 1}, {"g", "h", 2},
+	} {
+		graph.addEdge(e.from, e.to, e.cost)
+	}
+	return graph
+}
+
+func Test_FindK_TestRankedLooplessPaths(t *testing.T) {
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](buildTestYenGraph())
+
+	actual := uc.FindK("c", "h", 3)
+	assert.Len(t, actual, 3)
+	assert.Equal(t, []string{"c", "e", "f", "h"}, actual[0].Vertices)
+	assert.Equal(t, 5, actual[0].Cost)
+	assert.Equal(t, []string{"c", "e", "g", "h"}, actual[1].Vertices)
+	assert.Equal(t, 7, actual[1].Cost)
+	assert.Equal(t, 8, actual[2].Cost)
+
+	for _, path := range uc.FindK("c", "h", 100) {
+		assert.True(t, path.Found)
+		assert.Len(t, path.Edges, len(path.Vertices)-1)
+
+		visited := map[string]bool{}
+		for _, v := range path.Vertices {
+			assert.False(t, visited[v], "loop at %s in %v", v, path.Vertices)
+			visited[v] = true
+		}
+	}
+}
+
+func Test_FindK_TestFewerPathsThanK(t *testing.T) {
+	graph := &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+	graph.buildTestByFuncGraph()
+
+	uc := shortest_path.NewUniformCostByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost)
+
+	actual := uc.FindK("a", "g", 10)
+	assert.Len(t, actual, 3)
+	assert.Equal(t, "a,d,f,g", ByFuncString(actual[0].Path))
+	assert.Equal(t, 8, actual[0].Cost)
+	assert.Equal(t, "a,b,c,g", ByFuncString(actual[1].Path))
+	assert.Equal(t, 14, actual[1].Cost)
+	assert.Equal(t, "a,d,e,b,c,g", ByFuncString(actual[2].Path))
+	assert.Equal(t, 18, actual[2].Cost)
+
+	assert.Empty(t, uc.FindK("a", "h", 3))
+	assert.Empty(t, uc.FindK("a", "g", 0))
+	assert.Empty(t, uc.FindK(nil, "g", 3))
+}
+
+func Test_FindK_TestByInterface(t *testing.T) {
+	graph := &testByInterfaceGraph{}
+	graph.buildTestByInterfaceGraph()
+
+	uc := shortest_path.NewUniformCostByInterface().(shortest_path.KFinder)
+
+	actual := uc.FindK(graph.vs["a"], graph.vs["g"], 2)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, "a,d,f,g", ByInterfaceString(actual[0].Path))
+	assert.Equal(t, "a,b,c,g", ByInterfaceString(actual[1].Path))
+
+	assert.Empty(t, uc.FindK("a", graph.vs["g"], 2))
+}
@@ -0,0 +1,311 @@
+package shortest_path
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// LandmarkSelection is how NewLandmarks picks its landmarks
+type LandmarkSelection int
+
+const (
+	// FarthestLandmarks picks every landmark as far as possible from the ones
+	// picked before, vertices they cannot reach are preferred
+	FarthestLandmarks LandmarkSelection = iota
+	// RandomLandmarks picks landmarks at random
+	RandomLandmarks
+)
+
+// LandmarkOptions configure NewLandmarks
+type LandmarkOptions struct {
+	// Count is the number of landmarks, at most the number of vertices
+	Count     int
+	Selection LandmarkSelection
+	// Seed of the random choices, FarthestLandmarks starts from a random vertex
+	Seed int64
+}
+
+// Landmarks hold the costs from and to a few landmark vertices, which bound
+// the cost between any two vertices through the triangle inequality (ALT)
+type Landmarks[V comparable, E any, C Numeric] struct {
+	// Vertices are the landmarks, in the order they were picked
+	Vertices []V
+
+	graph Graph[V, E, C]
+	ids   map[V]int
+	// from[i][id] is the cost from landmark i to the vertex, to[i][id] the
+	// cost back to the landmark, negative when there is no path. to is nil
+	// when the graph cannot be walked backwards
+	from [][]C
+	to   [][]C
+}
+
+// NewLandmarks picks landmarks among vertices, duplicates counted once, and
+// computes their costs with FindAll. The costs to the landmarks are only
+// known, and the bound only as tight, when graph is a ReverseGraph. Edge
+// costs must not be negative
+func NewLandmarks[V comparable, E any, C Numeric](graph Graph[V, E, C], vertices []V, options LandmarkOptions) (*Landmarks[V, E, C], error) {
+	distinct := make([]V, 0, len(vertices))
+	seen := make(map[V]bool, len(vertices))
+	for _, v := range vertices {
+		if !seen[v] {
+			seen[v] = true
+			distinct = append(distinct, v)
+		}
+	}
+	vertices = distinct
+
+	if options.Count <= 0 || options.Count > len(vertices) {
+		return nil, fmt.Errorf("landmark count %d out of range 1 to %d", options.Count, len(vertices))
+	}
+	if options.Selection != FarthestLandmarks && options.Selection != RandomLandmarks {
+		return nil, fmt.Errorf("unknown landmark selection %d", options.Selection)
+	}
+
+	l := &Landmarks[V, E, C]{
+		Vertices: []V{},
+		graph:    graph,
+		ids:      make(map[V]int, len(vertices)),
+		from:     [][]C{},
+	}
+	for _, v := range vertices {
+		l.index(v)
+	}
+
+	forward := NewUniformCost(graph)
+	var backward *Search[V, E, C]
+	if reverse, ok := graph.(ReverseGraph[V, E, C]); ok {
+		backward = NewUniformCost[V, E, C](&reversed[V, E, C]{reverse})
+		l.to = [][]C{}
+	}
+
+	r := rand.New(rand.NewSource(options.Seed))
+	picked := map[V]bool{}
+	// closest is the cost from the nearest landmark to every vertex, for FarthestLandmarks
+	var closest []C
+
+	next := vertices[r.Intn(len(vertices))]
+	if options.Selection == FarthestLandmarks {
+		tree := forward.FindAll(next)
+		if tree.Err != nil {
+			return nil, tree.Err
+		}
+		next = l.farthest(vertices, picked, tree.Cost)
+	}
+
+	for len(l.Vertices) < options.Count {
+		picked[next] = true
+		l.Vertices = append(l.Vertices, next)
+
+		tree := forward.FindAll(next)
+		if tree.Err != nil {
+			return nil, tree.Err
+		}
+		l.from = append(l.from, l.costs(tree.Cost))
+		if backward != nil {
+			tree := backward.FindAll(next)
+			if tree.Err != nil {
+				return nil, tree.Err
+			}
+			l.to = append(l.to, l.costs(tree.Cost))
+		}
+
+		switch options.Selection {
+		case FarthestLandmarks:
+			closest = l.closer(closest, l.from[len(l.from)-1])
+			costs := map[V]C{}
+			for _, v := range vertices {
+				if cost := closest[l.ids[v]]; cost >= 0 {
+					costs[v] = cost
+				}
+			}
+			next = l.farthest(vertices, picked, costs)
+		case RandomLandmarks:
+			for picked[next] && len(picked) < len(vertices) {
+				next = vertices[r.Intn(len(vertices))]
+			}
+		}
+	}
+
+	return l, nil
+}
+
+// index returns the dense id of v, adding it if needed
+func (l *Landmarks[V, E, C]) index(v V) int {
+	id, found := l.ids[v]
+	if !found {
+		id = len(l.ids)
+		l.ids[v] = id
+		for i := range l.from {
+			l.from[i] = append(l.from[i], -1)
+		}
+		for i := range l.to {
+			l.to[i] = append(l.to[i], -1)
+		}
+	}
+	return id
+}
+
+// costs turns the costs of a tree into a dense row, -1 for unreached vertices
+func (l *Landmarks[V, E, C]) costs(tree map[V]C) []C {
+	// vertices reachable but missing from the list are indexed too
+	for v := range tree {
+		l.index(v)
+	}
+	row := make([]C, len(l.ids))
+	for i := range row {
+		row[i] = -1
+	}
+	for v, cost := range tree {
+		row[l.ids[v]] = cost
+	}
+	return row
+}
+
+// closer returns the lower of the known costs of the two rows
+func (l *Landmarks[V, E, C]) closer(closest, row []C) []C {
+	if closest == nil {
+		return append([]C{}, row...)
+	}
+	for id, cost := range row {
+		if id >= len(closest) {
+			closest = append(closest, cost)
+			continue
+		}
+		if cost >= 0 && (closest[id] < 0 || cost < closest[id]) {
+			closest[id] = cost
+		}
+	}
+	return closest
+}
+
+// farthest returns the first vertex not yet picked and missing from costs,
+// or else the one with the highest cost
+func (l *Landmarks[V, E, C]) farthest(vertices []V, picked map[V]bool, costs map[V]C) V {
+	var farthest V
+	found := false
+	for _, v := range vertices {
+		if picked[v] {
+			continue
+		}
+		cost, reached := costs[v]
+		if !reached {
+			return v
+		}
+		if !found || costs[farthest] < cost {
+			farthest = v
+			found = true
+		}
+	}
+	return farthest
+}
+
+// Heuristic returns the ALT lower bound on the cost from vertex to goal, the
+// best over every landmark L of cost(L, goal) - cost(L, vertex) and
+// cost(vertex, L) - cost(goal, L). It is consistent, and zero for vertices
+// the landmarks know nothing about
+func (l *Landmarks[V, E, C]) Heuristic() Heuristic[V, C] {
+	return func(vertex, goal V) C {
+		var bound C
+		v, found := l.ids[vertex]
+		if !found {
+			return bound
+		}
+		g, found := l.ids[goal]
+		if !found {
+			return bound
+		}
+
+		for i := range l.Vertices {
+			if from := l.from[i]; from[v] >= 0 && from[g] >= 0 && from[g]-from[v] > bound {
+				bound = from[g] - from[v]
+			}
+			if l.to == nil {
+				continue
+			}
+			if to := l.to[i]; to[v] >= 0 && to[g] >= 0 && to[v]-to[g] > bound {
+				bound = to[v] - to[g]
+			}
+		}
+		return bound
+	}
+}
+
+// NewAStar creates an A* search over the graph of the landmarks guided by their Heuristic
+func (l *Landmarks[V, E, C]) NewAStar() *Search[V, E, C] {
+	return NewAStar(l.graph, l.Heuristic())
+}
+
+// Memory returns the approximate number of bytes used by the landmark costs,
+// without the vertex index
+func (l *Landmarks[V, E, C]) Memory() int {
+	const word = 8
+	return word * len(l.ids) * (len(l.from) + len(l.to))
+}
+
+// LandmarkReduction compares the work done by queries with and without the landmarks
+type LandmarkReduction struct {
+	// Uniform and Landmarks are the Stats summed over every query, searched
+	// by uniform cost and by A* with the landmark Heuristic
+	Uniform   Stats
+	Landmarks Stats
+}
+
+// Ratio returns the fraction of the uniform cost expansions A* still needs,
+// lower is better
+func (r *LandmarkReduction) Ratio() float64 {
+	if r.Uniform.Expansions == 0 {
+		return 1
+	}
+	return float64(r.Landmarks.Expansions) / float64(r.Uniform.Expansions)
+}
+
+// Reduction runs every query from queries[i][0] to queries[i][1] with uniform
+// cost and with the landmarks, it fails if they find different costs
+func (l *Landmarks[V, E, C]) Reduction(queries [][2]V) (*LandmarkReduction, error) {
+	uniform := NewUniformCost(l.graph)
+	astar := l.NewAStar()
+	reduction := &LandmarkReduction{}
+
+	add := func(total *Stats, stats Stats) {
+		total.Expansions += stats.Expansions
+		total.Pushes += stats.Pushes
+		if stats.PeakQueue > total.PeakQueue {
+			total.PeakQueue = stats.PeakQueue
+		}
+	}
+	for _, query := range queries {
+		expected := uniform.Find(query[0], query[1])
+		if expected.Err != nil {
+			return nil, expected.Err
+		}
+		actual := astar.Find(query[0], query[1])
+		if actual.Err != nil {
+			return nil, actual.Err
+		}
+		if expected.Found != actual.Found || expected.Cost != actual.Cost {
+			return nil, fmt.Errorf("landmarks found %v -> %v costing %v, not %v", query[0], query[1], actual.Cost, expected.Cost)
+		}
+
+		add(&reduction.Uniform, expected.Stats)
+		add(&reduction.Landmarks, actual.Stats)
+	}
+	return reduction, nil
+}
+
+// reversed walks the edges of a ReverseGraph backwards
+type reversed[V comparable, E any, C any] struct {
+	graph ReverseGraph[V, E, C]
+}
+
+func (r *reversed[V, E, C]) Edges(vertex V) []E {
+	return r.graph.InEdges(vertex)
+}
+
+func (r *reversed[V, E, C]) EdgeEnd(edge E) V {
+	return r.graph.EdgeStart(edge)
+}
+
+func (r *reversed[V, E, C]) EdgeCost(edge E) C {
+	return r.graph.EdgeCost(edge)
+}
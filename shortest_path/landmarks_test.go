@@ -0,0 +1,146 @@
+package shortest_path_test
+
+import (
+	"errors"
+	"fatdes/go_algo/shortest_path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLandmarkGraph() (shortest_path.ReverseGraph[int, testBenchEdge, int], []int) {
+	out, in := newTestBenchGraph(300, 4)
+	vertices := make([]int, len(out))
+	for v := range vertices {
+		vertices[v] = v
+	}
+	return shortest_path.NewReverseFuncGraph(
+		func(v int) []testBenchEdge { return out[v] },
+		func(edge testBenchEdge) int { return edge.to },
+		func(edge testBenchEdge) int { return edge.cost },
+		func(v int) []testBenchEdge { return in[v] },
+		func(edge testBenchEdge) int { return edge.from },
+	), vertices
+}
+
+func Test_Landmarks_TestFarthest(t *testing.T) {
+	graph, vertices := newTestLandmarkGraph()
+	landmarks, err := shortest_path.NewLandmarks(graph, vertices, shortest_path.LandmarkOptions{Count: 4})
+	assert.NoError(t, err)
+	assert.Len(t, landmarks.Vertices, 4)
+	// costs from and to every landmark
+	assert.Equal(t, 8*300*4*2, landmarks.Memory())
+
+	uc := shortest_path.NewUniformCost(graph)
+	astar := landmarks.NewAStar()
+	h := landmarks.Heuristic()
+	for _, goal := range []int{0, 17, 150, 299} {
+		assert.Empty(t, shortest_path.CheckAdmissible(uc, h, vertices, goal))
+		for from := 0; from < 300; from += 7 {
+			expected := uc.Find(from, goal)
+			actual := astar.Find(from, goal)
+			assert.Equal(t, expected.Found, actual.Found)
+			assert.Equal(t, expected.Cost, actual.Cost)
+		}
+	}
+
+	// the bound is exact towards a landmark
+	landmark := landmarks.Vertices[0]
+	assert.Equal(t, uc.Find(5, landmark).Cost, h(5, landmark))
+}
+
+func Test_Landmarks_TestRandom(t *testing.T) {
+	graph, vertices := newTestLandmarkGraph()
+	landmarks, err := shortest_path.NewLandmarks(graph, vertices, shortest_path.LandmarkOptions{Count: 300, Selection: shortest_path.RandomLandmarks, Seed: 3})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, vertices, landmarks.Vertices)
+
+	// with every vertex a landmark the bound is exact
+	uc := shortest_path.NewUniformCost(graph)
+	assert.Equal(t, uc.Find(3, 42).Cost, landmarks.Heuristic()(3, 42))
+}
+
+func Test_Landmarks_TestReduction(t *testing.T) {
+	graph, vertices := newTestLandmarkGraph()
+	landmarks, _ := shortest_path.NewLandmarks(graph, vertices, shortest_path.LandmarkOptions{Count: 8})
+
+	queries := [][2]int{}
+	for i := 0; i < 50; i++ {
+		queries = append(queries, [2]int{i, (i*7919 + 1) % 300})
+	}
+	reduction, err := landmarks.Reduction(queries)
+	assert.NoError(t, err)
+	assert.Less(t, reduction.Landmarks.Expansions, reduction.Uniform.Expansions)
+	assert.Less(t, reduction.Ratio(), 1.0)
+	assert.Equal(t, 1.0, (&shortest_path.LandmarkReduction{}).Ratio())
+}
+
+func Test_Landmarks_TestForwardOnly(t *testing.T) {
+	graph := newTestTypedGraph()
+	vertices := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	landmarks, err := shortest_path.NewLandmarks[string, *testTypedEdge](graph, vertices, shortest_path.LandmarkOptions{Count: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 8*8*2, landmarks.Memory())
+
+	// h has no edges, it cannot be reached from the first landmark and is picked next
+	assert.Contains(t, landmarks.Vertices, "h")
+
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](graph)
+	assert.Empty(t, shortest_path.CheckAdmissible(uc, landmarks.Heuristic(), vertices, "g"))
+	actual := landmarks.NewAStar().Find("a", "g")
+	assert.Equal(t, 8, actual.Cost)
+	assert.Equal(t, []string{"a", "d", "f", "g"}, actual.Vertices)
+
+	assert.Equal(t, 0, landmarks.Heuristic()("x", "g"))
+}
+
+func Test_Landmarks_TestByFunc(t *testing.T) {
+	graph := &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+	graph.buildTestByFuncGraph()
+	vertices := []interface{}{"a", "b", "c", "d", "e", "f", "g", "h"}
+	landmarks, err := shortest_path.NewLandmarks(
+		shortest_path.NewFuncGraph[interface{}, interface{}, int](graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost),
+		vertices,
+		shortest_path.LandmarkOptions{Count: 3},
+	)
+	assert.NoError(t, err)
+
+	astar := shortest_path.NewAStarByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost, landmarks.Heuristic())
+	actual := astar.Find("a", "g")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 8, actual.Cost)
+	assert.Equal(t, "a,d,f,g", ByFuncString(actual.Path))
+}
+
+func Test_Landmarks_TestErrors(t *testing.T) {
+	graph := newTestTypedGraph()
+	vertices := []string{"a", "b"}
+
+	_, err := shortest_path.NewLandmarks[string, *testTypedEdge](graph, vertices, shortest_path.LandmarkOptions{Count: 0})
+	assert.Error(t, err)
+	_, err = shortest_path.NewLandmarks[string, *testTypedEdge](graph, vertices, shortest_path.LandmarkOptions{Count: 3})
+	assert.Error(t, err)
+	_, err = shortest_path.NewLandmarks[string, *testTypedEdge](graph, vertices, shortest_path.LandmarkOptions{Count: 1, Selection: 7})
+	assert.Error(t, err)
+
+	negative := newTestNegativeGraph()
+	_, err = shortest_path.NewLandmarks[string, *testTypedEdge](negative, []string{"a", "b", "c", "d"}, shortest_path.LandmarkOptions{Count: 2})
+	assert.True(t, errors.Is(err, shortest_path.ErrNegativeCost))
+}
+
+func Test_Landmarks_TestDuplicates(t *testing.T) {
+	graph := newTestTypedGraph()
+	vertices := []string{"a", "a", "b", "a", "b"}
+
+	for _, selection := range []shortest_path.LandmarkSelection{shortest_path.FarthestLandmarks, shortest_path.RandomLandmarks} {
+		_, err := shortest_path.NewLandmarks[string, *testTypedEdge](graph, []string{"a", "a"}, shortest_path.LandmarkOptions{Count: 2, Selection: selection})
+		assert.Error(t, err)
+		_, err = shortest_path.NewLandmarks[string, *testTypedEdge](graph, vertices, shortest_path.LandmarkOptions{Count: 3, Selection: selection})
+		assert.Error(t, err)
+
+		// as many landmarks as distinct vertices picks each of them once
+		landmarks, err := shortest_path.NewLandmarks[string, *testTypedEdge](graph, vertices, shortest_path.LandmarkOptions{Count: 2, Selection: selection})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"a", "b"}, landmarks.Vertices)
+	}
+}
@@ -0,0 +1,129 @@
+package shortest_path
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrMaxExpansions is reported when a search expands Options.MaxExpansions vertices
+	ErrMaxExpansions = errors.New("maximum expansions reached")
+	// ErrMaxCost is reported when every remaining path costs more than Options.MaxCost
+	ErrMaxCost = errors.New("maximum cost exceeded")
+)
+
+// Options limit a search, zero means no limit
+type Options[C any] struct {
+	// MaxExpansions is the number of vertices the search may expand
+	MaxExpansions int
+	// MaxCost is the highest path cost accepted, Bellman-Ford searches only
+	// check the path found as their costs may decrease
+	MaxCost C
+}
+
+// LimitError is reported when a search is stopped by its context or Options,
+// Err is the context error, ErrMaxExpansions or ErrMaxCost
+type LimitError[V comparable, E any, C any] struct {
+	Err error
+
+	Expansions int
+	// LowerBound on the cost of any path to the target, not set for Bellman-Ford
+	LowerBound C
+	// Closest is the path to the settled vertex estimated closest to the
+	// target, latest settled on ties, nil for Bellman-Ford
+	Closest *Path[V, E, C]
+}
+
+func (e *LimitError[V, E, C]) Error() string {
+	return fmt.Sprintf("search stopped after %d expansions: %v", e.Expansions, e.Err)
+}
+
+func (e *LimitError[V, E, C]) Unwrap() error {
+	return e.Err
+}
+
+// limiter enforces the context and Options of a search, a nil limiter never stops
+type limiter[V comparable, E any, C any] struct {
+	ctx     context.Context
+	options Options[C]
+	costs   costs[C]
+
+	expansions int
+	lowerBound C
+	closest    *node[V, E, C]
+}
+
+func newLimiter[V comparable, E any, C any](ctx context.Context, options *Options[C], costs costs[C]) *limiter[V, E, C] {
+	l := &limiter[V, E, C]{ctx: ctx, costs: costs}
+	if options != nil {
+		l.options = *options
+	}
+	return l
+}
+
+// settle is called before expanding a vertex, lowerBound is the least cost of
+// any path to the target not found yet if bounded, and n is the expanded node
+// if it is a candidate for the closest vertex
+func (l *limiter[V, E, C]) settle(n *node[V, E, C], lowerBound C, bounded bool) error {
+	if l == nil {
+		return nil
+	}
+
+	if n != nil && (l.closest == nil || !l.costs.less(l.closest.estimate, n.estimate)) {
+		l.closest = n
+	}
+	if bounded && l.costs.less(l.lowerBound, lowerBound) {
+		l.lowerBound = lowerBound
+	}
+
+	var err error
+	switch {
+	case l.ctx.Err() != nil:
+		err = l.ctx.Err()
+	case bounded && !l.costs.zero(l.options.MaxCost) && l.costs.less(l.options.MaxCost, lowerBound):
+		err = ErrMaxCost
+	case l.options.MaxExpansions > 0 && l.expansions >= l.options.MaxExpansions:
+		err = ErrMaxExpansions
+	}
+
+	if err != nil {
+		limitErr := &LimitError[V, E, C]{
+			Err:        err,
+			Expansions: l.expansions,
+			LowerBound: l.lowerBound,
+		}
+		if l.closest != nil {
+			limitErr.Closest = l.closest.toPath()
+		}
+		return limitErr
+	}
+
+	l.expansions++
+	return nil
+}
+
+// accept rejects a path found that costs more than MaxCost
+func (l *limiter[V, E, C]) accept(path *Path[V, E, C]) *Path[V, E, C] {
+	if !path.Found || l.costs.zero(l.options.MaxCost) || !l.costs.less(l.options.MaxCost, path.Cost) {
+		return path
+	}
+
+	return &Path[V, E, C]{
+		Found: false,
+		Stats: path.Stats,
+		Err: &LimitError[V, E, C]{
+			Err:        ErrMaxCost,
+			Expansions: l.expansions,
+			LowerBound: path.Cost,
+			Closest:    path,
+		},
+	}
+}
+
+// FindContext finds the cheapest path from -> to like Find, but stops with a
+// LimitError when ctx is done or options are exceeded
+func (s *Search[V, E, C]) FindContext(ctx context.Context, from, to V, options *Options[C]) *Path[V, E, C] {
+	limits := newLimiter[V, E, C](ctx, options, s.costs)
+	return limits.accept(s.find(from, to, limits))
+}
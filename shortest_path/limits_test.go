@@ -0,0 +1,122 @@
+package shortest_path_test
+
+import (
+	"context"
+	"errors"
+	"fatdes/go_algo/shortest_path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestUnboundedGraph is lazily generated, v -> v+1 and v -> 2v both cost 1
+func newTestUnboundedGraph() shortest_path.Graph[int, [2]int, int] {
+	return shortest_path.NewFuncGraph(
+		func(v int) [][2]int { return [][2]int{{v, v + 1}, {v, 2 * v}} },
+		func(edge [2]int) int { return edge[1] },
+		func(edge [2]int) int { return 1 },
+	)
+}
+
+func Test_FindContext_TestWithinLimits(t *testing.T) {
+	uc := shortest_path.NewUniformCost(newTestUnboundedGraph())
+
+	actual := uc.FindContext(context.Background(), 1, 10, &shortest_path.Options[int]{MaxExpansions: 100, MaxCost: 10})
+	assert.NoError(t, actual.Err)
+	assert.True(t, actual.Found)
+	assert.Equal(t, 4, actual.Cost)
+	assert.Equal(t, []int{1, 2, 4, 5, 10}, actual.Vertices)
+
+	actual = uc.FindContext(context.Background(), 1, 10, nil)
+	assert.True(t, actual.Found)
+}
+
+func Test_FindContext_TestMaxExpansions(t *testing.T) {
+	uc := shortest_path.NewUniformCost(newTestUnboundedGraph())
+
+	actual := uc.FindContext(context.Background(), 1, -1, &shortest_path.Options[int]{MaxExpansions: 50})
+	assert.False(t, actual.Found)
+	assert.True(t, errors.Is(actual.Err, shortest_path.ErrMaxExpansions))
+
+	var limitErr *shortest_path.LimitError[int, [2]int, int]
+	assert.True(t, errors.As(actual.Err, &limitErr))
+	assert.Equal(t, 50, limitErr.Expansions)
+	assert.Greater(t, limitErr.LowerBound, 0)
+	assert.NotNil(t, limitErr.Closest)
+	assert.Equal(t, limitErr.Closest.Cost, len(limitErr.Closest.Edges))
+	assert.Equal(t, "search stopped after 50 expansions: maximum expansions reached", actual.Err.Error())
+}
+
+func Test_FindContext_TestMaxCost(t *testing.T) {
+	uc := shortest_path.NewUniformCost(newTestUnboundedGraph())
+
+	actual := uc.FindContext(context.Background(), 1, -1, &shortest_path.Options[int]{MaxCost: 5})
+	assert.False(t, actual.Found)
+	assert.True(t, errors.Is(actual.Err, shortest_path.ErrMaxCost))
+
+	var limitErr *shortest_path.LimitError[int, [2]int, int]
+	assert.True(t, errors.As(actual.Err, &limitErr))
+	assert.Equal(t, 6, limitErr.LowerBound)
+}
+
+func Test_FindContext_TestCancelled(t *testing.T) {
+	uc := shortest_path.NewUniformCost(newTestUnboundedGraph())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	actual := uc.FindContext(ctx, 1, -1, nil)
+	assert.True(t, errors.Is(actual.Err, context.Canceled))
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	actual = uc.FindContext(ctx, 1, -1, nil)
+	assert.True(t, errors.Is(actual.Err, context.DeadlineExceeded))
+}
+
+func Test_FindContext_TestAStarClosest(t *testing.T) {
+	graph := &testGridGraph{width: 10, height: 10}
+	astar := shortest_path.NewAStar(shortest_path.NewFuncGraph(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost),
+		shortest_path.Manhattan[int](testGridPosition))
+
+	actual := astar.FindContext(context.Background(), "0,0", "9,0", &shortest_path.Options[int]{MaxExpansions: 5})
+	var limitErr *shortest_path.LimitError[interface{}, interface{}, int]
+	assert.True(t, errors.As(actual.Err, &limitErr))
+	assert.Equal(t, []interface{}{"0,0", "1,0", "2,0", "3,0", "4,0", "5,0"}, limitErr.Closest.Vertices)
+	assert.Equal(t, 9, limitErr.LowerBound)
+}
+
+func Test_FindContext_TestBidirectionalAndBellmanFord(t *testing.T) {
+	graph := &testGridGraph{width: 31, height: 21}
+	bi := shortest_path.NewBidirectionalByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost, graph.getEdges, graph.getEdgeEnd)
+
+	actual := bi.FindContext(context.Background(), "0,0", "30,20", &shortest_path.Options[int]{MaxExpansions: 10})
+	assert.True(t, errors.Is(actual.Err, shortest_path.ErrMaxExpansions))
+	actual = bi.FindContext(context.Background(), "0,0", "30,20", &shortest_path.Options[int]{MaxCost: 20})
+	assert.True(t, errors.Is(actual.Err, shortest_path.ErrMaxCost))
+	actual = bi.FindContext(context.Background(), "0,0", "30,20", &shortest_path.Options[int]{MaxCost: 50})
+	assert.True(t, actual.Found)
+
+	bf := shortest_path.NewBellmanFord(newTestUnboundedGraph())
+	path := bf.FindContext(context.Background(), 1, 10, &shortest_path.Options[int]{MaxExpansions: 10})
+	assert.True(t, errors.Is(path.Err, shortest_path.ErrMaxExpansions))
+}
+
+func Test_FindContext_TestByInterface(t *testing.T) {
+	graph := &testByInterfaceGraph{}
+	graph.buildTestByInterfaceGraph()
+
+	uc := shortest_path.NewUniformCostByInterface().(shortest_path.ContextFinder)
+
+	actual := uc.FindContext(context.Background(), graph.vs["a"], graph.vs["g"], &shortest_path.Options[int]{MaxCost: 7})
+	assert.False(t, actual.Found)
+	assert.True(t, errors.Is(actual.Err, shortest_path.ErrMaxCost))
+
+	actual = uc.FindContext(context.Background(), graph.vs["a"], graph.vs["g"], &shortest_path.Options[int]{MaxCost: 8})
+	assert.True(t, actual.Found)
+	assert.Equal(t, "a,d,f,g", ByInterfaceString(actual.Path))
+
+	actual = uc.FindContext(context.Background(), "a", graph.vs["g"], nil)
+	assert.False(t, actual.Found)
+	assert.NoError(t, actual.Err)
+}
@@ -0,0 +1,56 @@
+package loader
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReadCSV reads an edge list with one from,to[,cost] record per line, the
+// cost defaults to 1. Lines starting with # are comments, and a first record
+// whose cost is "cost" is a header
+func ReadCSV(r io.Reader) (*Graph, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	graph := NewGraph()
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return graph, nil
+		}
+		if err != nil {
+			var csvErr *csv.ParseError
+			if errors.As(err, &csvErr) {
+				return nil, &ParseError{Line: csvErr.Line, Err: csvErr.Err}
+			}
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		if len(record) < 2 || len(record) > 3 {
+			return nil, parseErrorf(line, "want from,to[,cost], got %d fields", len(record))
+		}
+		from, to := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		if from == "" || to == "" {
+			return nil, parseErrorf(line, "empty vertex")
+		}
+
+		cost := 1
+		if len(record) == 3 {
+			field := strings.TrimSpace(record[2])
+			if first && strings.EqualFold(field, "cost") {
+				continue
+			}
+			if cost, err = strconv.Atoi(field); err != nil {
+				return nil, parseErrorf(line, "cost %q is not an integer", field)
+			}
+		}
+
+		graph.AddEdge(from, to, cost)
+	}
+}
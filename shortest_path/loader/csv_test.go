@@ -0,0 +1,40 @@
+package loader_test
+
+import (
+	"errors"
+	"fatdes/go_algo/shortest_path/loader"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CSV_TestRead(t *testing.T) {
+	graph, err := loader.ReadCSV(strings.NewReader(`from,to,cost
+# comment
+a,b,5
+a, d, 3
+d,b
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "d"}, graph.Vertices)
+	assert.Equal(t, 3, graph.EdgeCount())
+	assert.Equal(t, []*loader.Edge{{From: "a", To: "b", Cost: 5}, {From: "a", To: "d", Cost: 3}}, graph.Edges("a"))
+	assert.Equal(t, 1, graph.Edges("d")[0].Cost)
+	assert.Len(t, graph.InEdges("b"), 2)
+}
+
+func Test_CSV_TestParseErrors(t *testing.T) {
+	for input, line := range map[string]int{
+		"a,b,1\na,b,x\n":   2,
+		"a,b,1\n\na\n":     3,
+		"a,b,1,2\n":        1,
+		"a,,1\n":           1,
+		"a,b,1\n\"a,b,1\n": 2,
+	} {
+		_, err := loader.ReadCSV(strings.NewReader(input))
+		var parseErr *loader.ParseError
+		assert.True(t, errors.As(err, &parseErr), input)
+		assert.Equal(t, line, parseErr.Line, input)
+	}
+}
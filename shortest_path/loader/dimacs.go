@@ -0,0 +1,146 @@
+package loader
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// lines calls read with every non blank line that is not a "c" comment, and
+// its fields. It returns the number of lines
+func lines(r io.Reader, read func(line int, fields []string) error) (int, error) {
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] == "c" {
+			continue
+		}
+		if err := read(line, fields); err != nil {
+			return line, err
+		}
+	}
+	return line, scanner.Err()
+}
+
+// dimacsVertex parses a vertex id between 1 and n
+func dimacsVertex(line int, field string, n int) (string, error) {
+	id, err := strconv.Atoi(field)
+	if err != nil || id < 1 || id > n {
+		return "", parseErrorf(line, "vertex %q is not between 1 and %d", field, n)
+	}
+	return strconv.Itoa(id), nil
+}
+
+// ReadDIMACS reads a graph in the DIMACS shortest path .gr format: a
+// "p sp <vertices> <arcs>" line followed by "a <from> <to> <cost>" lines.
+// Vertices are named "1" to "<vertices>"
+func ReadDIMACS(r io.Reader) (*Graph, error) {
+	graph := NewGraph()
+	n, arcs := -1, 0
+
+	last, err := lines(r, func(line int, fields []string) error {
+		switch fields[0] {
+		case "p":
+			if n >= 0 {
+				return parseErrorf(line, "duplicate problem line")
+			}
+			if len(fields) != 4 || fields[1] != "sp" {
+				return parseErrorf(line, "want p sp <vertices> <arcs>")
+			}
+			var err error
+			if n, err = strconv.Atoi(fields[2]); err != nil || n < 0 {
+				return parseErrorf(line, "vertex count %q is not a natural number", fields[2])
+			}
+			if arcs, err = strconv.Atoi(fields[3]); err != nil || arcs < 0 {
+				return parseErrorf(line, "arc count %q is not a natural number", fields[3])
+			}
+			for id := 1; id <= n; id++ {
+				graph.AddVertex(strconv.Itoa(id))
+			}
+		case "a":
+			if n < 0 {
+				return parseErrorf(line, "arc before problem line")
+			}
+			if len(fields) != 4 {
+				return parseErrorf(line, "want a <from> <to> <cost>")
+			}
+			from, err := dimacsVertex(line, fields[1], n)
+			if err != nil {
+				return err
+			}
+			to, err := dimacsVertex(line, fields[2], n)
+			if err != nil {
+				return err
+			}
+			cost, err := strconv.Atoi(fields[3])
+			if err != nil {
+				return parseErrorf(line, "cost %q is not an integer", fields[3])
+			}
+			graph.AddEdge(from, to, cost)
+		default:
+			return parseErrorf(line, "unknown line type %q", fields[0])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if n < 0 {
+		return nil, parseErrorf(last, "missing problem line")
+	}
+	if count := graph.EdgeCount(); count != arcs {
+		return nil, parseErrorf(last, "problem line declares %d arcs, found %d", arcs, count)
+	}
+
+	return graph, nil
+}
+
+// ReadDIMACSCoordinates reads the .co coordinates of the vertices of graph:
+// a "p aux sp co <vertices>" line followed by "v <id> <x> <y>" lines
+func ReadDIMACSCoordinates(graph *Graph, r io.Reader) error {
+	n := -1
+
+	_, err := lines(r, func(line int, fields []string) error {
+		switch fields[0] {
+		case "p":
+			if len(fields) != 5 || fields[1] != "aux" || fields[2] != "sp" || fields[3] != "co" {
+				return parseErrorf(line, "want p aux sp co <vertices>")
+			}
+			var err error
+			if n, err = strconv.Atoi(fields[4]); err != nil || n < 0 {
+				return parseErrorf(line, "vertex count %q is not a natural number", fields[4])
+			}
+		case "v":
+			if n < 0 {
+				return parseErrorf(line, "coordinates before problem line")
+			}
+			if len(fields) != 4 {
+				return parseErrorf(line, "want v <id> <x> <y>")
+			}
+			vertex, err := dimacsVertex(line, fields[1], n)
+			if err != nil {
+				return err
+			}
+			if !graph.HasVertex(vertex) {
+				return parseErrorf(line, "vertex %s is not in the graph", vertex)
+			}
+			x, err := strconv.ParseFloat(fields[2], 64)
+			if err != nil {
+				return parseErrorf(line, "x %q is not a number", fields[2])
+			}
+			y, err := strconv.ParseFloat(fields[3], 64)
+			if err != nil {
+				return parseErrorf(line, "y %q is not a number", fields[3])
+			}
+			graph.Coordinates[vertex] = [2]float64{x, y}
+		default:
+			return parseErrorf(line, "unknown line type %q", fields[0])
+		}
+		return nil
+	})
+	return err
+}
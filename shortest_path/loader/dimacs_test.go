@@ -0,0 +1,70 @@
+package loader_test
+
+import (
+	"errors"
+	"fatdes/go_algo/shortest_path/loader"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDIMACS = `c 9th DIMACS sample
+p sp 4 5
+a 1 2 4
+a 1 3 2
+a 3 2 1
+a 2 4 5
+a 3 4 8
+`
+
+func Test_DIMACS_TestRead(t *testing.T) {
+	graph, err := loader.ReadDIMACS(strings.NewReader(testDIMACS))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3", "4"}, graph.Vertices)
+	assert.Equal(t, 5, graph.EdgeCount())
+	assert.Equal(t, &loader.Edge{From: "3", To: "2", Cost: 1}, graph.Edges("3")[0])
+
+	err = loader.ReadDIMACSCoordinates(graph, strings.NewReader(`c coordinates
+p aux sp co 4
+v 1 0 0
+v 2 3 4
+`))
+	assert.NoError(t, err)
+	x, y := graph.Position("2")
+	assert.Equal(t, 3.0, x)
+	assert.Equal(t, 4.0, y)
+}
+
+func Test_DIMACS_TestParseErrors(t *testing.T) {
+	for input, line := range map[string]int{
+		"a 1 2 3\n":                      1,
+		"p sp 2 1\na 1 3 1\n":            2,
+		"p sp 2 1\nc\na 1 2 x\n":         3,
+		"p sp 2 2\na 1 2 1\n":            2,
+		"p sp 2 1\nx 1 2 1\n":            2,
+		"p sp 2 1\np sp 2 1\n":           2,
+		"p max 2 1\n":                    1,
+		"c only comments\n":              1,
+		"p sp 2 1\na 1 2 1\nv 1 2 3 4\n": 3,
+	} {
+		_, err := loader.ReadDIMACS(strings.NewReader(input))
+		var parseErr *loader.ParseError
+		assert.True(t, errors.As(err, &parseErr), input)
+		assert.Equal(t, line, parseErr.Line, input)
+	}
+
+	graph, _ := loader.ReadDIMACS(strings.NewReader(testDIMACS))
+	for input, line := range map[string]int{
+		"v 1 0 0\n":                1,
+		"p aux sp co 4\nv 5 0 0\n": 2,
+		"p aux sp co 4\nv 1 x 0\n": 2,
+		"p aux sp co 9\nv 9 0 0\n": 2,
+		"p aux sp co 4\n\nv 1 0\n": 3,
+	} {
+		err := loader.ReadDIMACSCoordinates(graph, strings.NewReader(input))
+		var parseErr *loader.ParseError
+		assert.True(t, errors.As(err, &parseErr), input)
+		assert.Equal(t, line, parseErr.Line, input)
+	}
+}
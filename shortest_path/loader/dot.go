@@ -0,0 +1,356 @@
+package loader
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// token of a DOT file, quoted is set for "..." ids
+type token struct {
+	text   string
+	line   int
+	quoted bool
+}
+
+// tokenize splits DOT source into ids, quoted ids, edge operators and
+// punctuation, dropping comments
+func tokenize(r io.Reader) ([]token, error) {
+	reader := bufio.NewReader(r)
+	tokens := []token{}
+	line := 1
+
+	next := func() (rune, bool) {
+		c, _, err := reader.ReadRune()
+		if err != nil {
+			return 0, false
+		}
+		return c, true
+	}
+	peek := func() rune {
+		c, _, err := reader.ReadRune()
+		if err != nil {
+			return 0
+		}
+		_ = reader.UnreadRune()
+		return c
+	}
+	skipLine := func() {
+		for c, ok := next(); ok && c != '\n'; c, ok = next() {
+		}
+		line++
+	}
+
+	for atLineStart := true; ; {
+		c, ok := next()
+		if !ok {
+			return tokens, nil
+		}
+
+		switch {
+		case c == '\n':
+			line++
+			atLineStart = true
+			continue
+		case unicode.IsSpace(c):
+			continue
+		case c == '#' && atLineStart:
+			skipLine()
+			continue
+		case c == '/' && peek() == '/':
+			skipLine()
+			atLineStart = true
+			continue
+		case c == '/' && peek() == '*':
+			next()
+			start := line
+			for previous := rune(0); ; {
+				c, ok := next()
+				if !ok {
+					return nil, parseErrorf(start, "unterminated comment")
+				}
+				if c == '\n' {
+					line++
+				}
+				if previous == '*' && c == '/' {
+					break
+				}
+				previous = c
+			}
+		case c == '"':
+			start := line
+			text := strings.Builder{}
+			for {
+				c, ok := next()
+				if !ok {
+					return nil, parseErrorf(start, "unterminated string")
+				}
+				if c == '"' {
+					break
+				}
+				if c == '\\' && peek() == '"' {
+					c, _ = next()
+				}
+				if c == '\n' {
+					line++
+				}
+				text.WriteRune(c)
+			}
+			tokens = append(tokens, token{text: text.String(), line: start, quoted: true})
+		case c == '-' && (peek() == '>' || peek() == '-'):
+			op, _ := next()
+			tokens = append(tokens, token{text: string([]rune{c, op}), line: line})
+		case strings.ContainsRune("{}[]=;,:", c):
+			tokens = append(tokens, token{text: string(c), line: line})
+		case c == '_' || c == '.' || c == '-' || unicode.IsLetter(c) || unicode.IsDigit(c):
+			text := strings.Builder{}
+			text.WriteRune(c)
+			for c := peek(); c == '_' || c == '.' || unicode.IsLetter(c) || unicode.IsDigit(c); c = peek() {
+				next()
+				text.WriteRune(c)
+			}
+			tokens = append(tokens, token{text: text.String(), line: line})
+		default:
+			return nil, parseErrorf(line, "unexpected character %q", c)
+		}
+		atLineStart = false
+	}
+}
+
+// dotParser reads the statements of a DOT graph
+type dotParser struct {
+	tokens []token
+	pos    int
+	graph  *Graph
+
+	directed bool
+	// weight of edges without a weight attribute
+	weight int
+}
+
+func (p *dotParser) peek() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// line of the current token, or the last one at the end
+func (p *dotParser) line() int {
+	if len(p.tokens) == 0 {
+		return 1
+	}
+	if p.pos >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1].line
+	}
+	return p.tokens[p.pos].line
+}
+
+// is returns true if the current token is the unquoted text, case insensitive for keywords
+func (p *dotParser) is(text string) bool {
+	t, ok := p.peek()
+	return ok && !t.quoted && strings.EqualFold(t.text, text)
+}
+
+func (p *dotParser) expect(text string) error {
+	if !p.is(text) {
+		return p.unexpected(fmt.Sprintf("%q", text))
+	}
+	p.pos++
+	return nil
+}
+
+func (p *dotParser) unexpected(want string) error {
+	t, ok := p.peek()
+	if !ok {
+		return parseErrorf(p.line(), "want %s, got end of file", want)
+	}
+	return parseErrorf(t.line, "want %s, got %q", want, t.text)
+}
+
+// id reads a vertex or attribute id
+func (p *dotParser) id() (string, error) {
+	t, ok := p.peek()
+	if !ok || !t.quoted && (strings.ContainsAny(t.text, "{}[]=;,:") || t.text == "->" || t.text == "--") {
+		return "", p.unexpected("id")
+	}
+	p.pos++
+	return t.text, nil
+}
+
+// attributes reads an optional [name=value, ...] list
+func (p *dotParser) attributes() (map[string]string, error) {
+	attributes := map[string]string{}
+	for p.is("[") {
+		p.pos++
+		for !p.is("]") {
+			name, err := p.id()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			value, err := p.id()
+			if err != nil {
+				return nil, err
+			}
+			attributes[strings.ToLower(name)] = value
+			if p.is(",") || p.is(";") {
+				p.pos++
+			}
+		}
+		p.pos++
+	}
+	return attributes, nil
+}
+
+// weightOf returns the weight or cost attribute, or the default weight
+func (p *dotParser) weightOf(attributes map[string]string, line int) (int, error) {
+	for _, name := range []string{"weight", "cost"} {
+		if value, found := attributes[name]; found {
+			weight, err := strconv.Atoi(value)
+			if err != nil {
+				return 0, parseErrorf(line, "%s %q is not an integer", name, value)
+			}
+			return weight, nil
+		}
+	}
+	return p.weight, nil
+}
+
+// vertex reads a node id, dropping any port
+func (p *dotParser) vertex() (string, error) {
+	if p.is("subgraph") || p.is("{") {
+		return "", parseErrorf(p.line(), "subgraphs are not supported")
+	}
+	id, err := p.id()
+	if err != nil {
+		return "", err
+	}
+	for p.is(":") {
+		p.pos++
+		if _, err := p.id(); err != nil {
+			return "", err
+		}
+	}
+	return id, nil
+}
+
+func (p *dotParser) statement() error {
+	line := p.line()
+
+	if p.is("graph") || p.is("node") || p.is("edge") {
+		isEdge := p.is("edge")
+		p.pos++
+		attributes, err := p.attributes()
+		if err != nil {
+			return err
+		}
+		if isEdge {
+			p.weight, err = p.weightOf(attributes, line)
+		}
+		return err
+	}
+
+	from, err := p.vertex()
+	if err != nil {
+		return err
+	}
+	if p.is("=") {
+		// graph attribute
+		p.pos++
+		_, err := p.id()
+		return err
+	}
+
+	chain := []string{from}
+	for p.is("->") || p.is("--") {
+		if op := p.tokens[p.pos].text; p.directed && op != "->" {
+			return parseErrorf(p.line(), "edge operator %s in a digraph", op)
+		} else if !p.directed && op != "--" {
+			return parseErrorf(p.line(), "edge operator %s in a graph", op)
+		}
+		p.pos++
+		to, err := p.vertex()
+		if err != nil {
+			return err
+		}
+		chain = append(chain, to)
+	}
+
+	attributes, err := p.attributes()
+	if err != nil {
+		return err
+	}
+	if len(chain) == 1 {
+		p.graph.AddVertex(from)
+		return nil
+	}
+
+	weight, err := p.weightOf(attributes, line)
+	if err != nil {
+		return err
+	}
+	for i := 1; i < len(chain); i++ {
+		p.graph.AddEdge(chain[i-1], chain[i], weight)
+		if !p.directed {
+			p.graph.AddEdge(chain[i], chain[i-1], weight)
+		}
+	}
+	return nil
+}
+
+// ReadDOT reads the subset of Graphviz DOT made of one graph or digraph with
+// node, edge and attribute statements, without subgraphs. Edge costs are
+// their weight or cost attribute, 1 by default, and an undirected edge is
+// an edge each way
+func ReadDOT(r io.Reader) (*Graph, error) {
+	tokens, err := tokenize(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &dotParser{tokens: tokens, graph: NewGraph(), weight: 1}
+	if p.is("strict") {
+		p.pos++
+	}
+	switch {
+	case p.is("digraph"):
+		p.directed = true
+	case p.is("graph"):
+	default:
+		return nil, p.unexpected(`"graph" or "digraph"`)
+	}
+	p.pos++
+	if !p.is("{") {
+		if _, err := p.id(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	for !p.is("}") {
+		if _, ok := p.peek(); !ok {
+			return nil, p.unexpected(`"}"`)
+		}
+		if p.is(";") {
+			p.pos++
+			continue
+		}
+		if err := p.statement(); err != nil {
+			return nil, err
+		}
+	}
+	p.pos++
+
+	if _, ok := p.peek(); ok {
+		return nil, p.unexpected("end of file")
+	}
+	return p.graph, nil
+}
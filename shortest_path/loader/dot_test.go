@@ -0,0 +1,62 @@
+package loader_test
+
+import (
+	"errors"
+	"fatdes/go_algo/shortest_path/loader"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DOT_TestDigraph(t *testing.T) {
+	graph, err := loader.ReadDOT(strings.NewReader(`# generated
+strict digraph "roads" {
+	rankdir = LR
+	node [shape=box]
+	edge [weight=2]
+	/* a block
+	   comment */
+	a -> b -> c; // chain
+	"a" -> "new york" [weight=7, color=red]
+	c:port -> a [cost="3"]
+	lonely
+}
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "new york", "lonely"}, graph.Vertices)
+	assert.Equal(t, []*loader.Edge{{From: "a", To: "b", Cost: 2}, {From: "a", To: "new york", Cost: 7}}, graph.Edges("a"))
+	assert.Equal(t, []*loader.Edge{{From: "c", To: "a", Cost: 3}}, graph.Edges("c"))
+	assert.Empty(t, graph.Edges("lonely"))
+}
+
+func Test_DOT_TestGraph(t *testing.T) {
+	graph, err := loader.ReadDOT(strings.NewReader(`graph { a -- b [weight=4] }`))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, graph.EdgeCount())
+	assert.Equal(t, 4, graph.Edges("b")[0].Cost)
+	assert.Equal(t, "a", graph.Edges("b")[0].To)
+}
+
+func Test_DOT_TestParseErrors(t *testing.T) {
+	for input, line := range map[string]int{
+		"tree {}":                          1,
+		"digraph {\na -- b\n}":             2,
+		"graph {\na -> b\n}":               2,
+		"digraph {\na -> b [weight=x]\n}":  2,
+		"digraph {\n\nsubgraph s { a }\n}": 3,
+		"digraph {\na -> \n}":              3,
+		"digraph {\na -> b\n":              2,
+		"digraph {\n\"a -> b\n}":           2,
+		"digraph {\n/* a -> b\n}":          2,
+		"digraph {\na -> b @\n}":           2,
+		"digraph {}\ngraph {}":             2,
+	} {
+		_, err := loader.ReadDOT(strings.NewReader(input))
+		var parseErr *loader.ParseError
+		assert.True(t, errors.As(err, &parseErr), input)
+		if parseErr != nil {
+			assert.Equal(t, line, parseErr.Line, input)
+		}
+	}
+}
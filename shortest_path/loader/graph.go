@@ -0,0 +1,196 @@
+// Package loader reads graphs from edge-list CSV, DIMACS and Graphviz DOT
+// files into a Graph that the shortest_path searches accept
+package loader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ParseError is a malformed line of a graph file
+type ParseError struct {
+	Line int
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+func parseErrorf(line int, format string, args ...interface{}) error {
+	return &ParseError{Line: line, Err: fmt.Errorf(format, args...)}
+}
+
+// Edge of a loaded Graph
+type Edge struct {
+	From string
+	To   string
+	Cost int
+}
+
+// Graph is a directed graph with string vertex ids. It implements
+// shortest_path.ReverseGraph[string, *Edge, int], and its ByFunc methods fit
+// the untyped constructors such as shortest_path.NewUniformCostByFunc
+type Graph struct {
+	// Vertices in the order they were first seen
+	Vertices []string
+	// Coordinates of vertices, if the file has them
+	Coordinates map[string][2]float64
+
+	out map[string][]*Edge
+	in  map[string][]*Edge
+}
+
+// NewGraph creates an empty Graph
+func NewGraph() *Graph {
+	return &Graph{
+		Coordinates: map[string][2]float64{},
+		out:         map[string][]*Edge{},
+		in:          map[string][]*Edge{},
+	}
+}
+
+// AddVertex adds vertex if it is new
+func (g *Graph) AddVertex(vertex string) {
+	if g.HasVertex(vertex) {
+		return
+	}
+	g.Vertices = append(g.Vertices, vertex)
+	g.out[vertex] = []*Edge{}
+	g.in[vertex] = []*Edge{}
+}
+
+// HasVertex returns true if vertex is in the graph
+func (g *Graph) HasVertex(vertex string) bool {
+	_, found := g.out[vertex]
+	return found
+}
+
+// AddEdge adds an edge from -> to, adding the vertices if they are new
+func (g *Graph) AddEdge(from, to string, cost int) *Edge {
+	g.AddVertex(from)
+	g.AddVertex(to)
+
+	edge := &Edge{From: from, To: to, Cost: cost}
+	g.out[from] = append(g.out[from], edge)
+	g.in[to] = append(g.in[to], edge)
+	return edge
+}
+
+// EdgeCount returns the number of edges
+func (g *Graph) EdgeCount() int {
+	count := 0
+	for _, edges := range g.out {
+		count += len(edges)
+	}
+	return count
+}
+
+func (g *Graph) Edges(vertex string) []*Edge {
+	return g.out[vertex]
+}
+
+func (g *Graph) EdgeEnd(edge *Edge) string {
+	return edge.To
+}
+
+func (g *Graph) EdgeCost(edge *Edge) int {
+	return edge.Cost
+}
+
+func (g *Graph) InEdges(vertex string) []*Edge {
+	return g.in[vertex]
+}
+
+func (g *Graph) EdgeStart(edge *Edge) string {
+	return edge.From
+}
+
+// Position returns the coordinates of vertex, for the shortest_path heuristics
+func (g *Graph) Position(vertex string) (float64, float64) {
+	position := g.Coordinates[vertex]
+	return position[0], position[1]
+}
+
+// ByFuncEdges is Edges for the untyped constructors, vertices are strings
+func (g *Graph) ByFuncEdges(vertex interface{}) []interface{} {
+	return toInterfaces(g.out[vertex.(string)])
+}
+
+// ByFuncEdgeEnd is EdgeEnd for the untyped constructors
+func (g *Graph) ByFuncEdgeEnd(edge interface{}) interface{} {
+	return edge.(*Edge).To
+}
+
+// ByFuncEdgeCost is EdgeCost for the untyped constructors
+func (g *Graph) ByFuncEdgeCost(edge interface{}) int {
+	return edge.(*Edge).Cost
+}
+
+// ByFuncInEdges is InEdges for the untyped bidirectional constructors
+func (g *Graph) ByFuncInEdges(vertex interface{}) []interface{} {
+	return toInterfaces(g.in[vertex.(string)])
+}
+
+// ByFuncEdgeStart is EdgeStart for the untyped bidirectional constructors
+func (g *Graph) ByFuncEdgeStart(edge interface{}) interface{} {
+	return edge.(*Edge).From
+}
+
+func toInterfaces(edges []*Edge) []interface{} {
+	result := make([]interface{}, len(edges))
+	for i, edge := range edges {
+		result[i] = edge
+	}
+	return result
+}
+
+// LoadFile reads a graph file by its extension: .csv, .gr (DIMACS) or .dot
+// and .gv (Graphviz). The coordinates of a .gr file are read from the .co
+// file next to it, if there is one
+func LoadFile(name string) (*Graph, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var graph *Graph
+	ext := strings.ToLower(filepath.Ext(name))
+	switch ext {
+	case ".csv":
+		graph, err = ReadCSV(file)
+	case ".gr":
+		graph, err = ReadDIMACS(file)
+	case ".dot", ".gv":
+		graph, err = ReadDOT(file)
+	default:
+		return nil, fmt.Errorf("%s: unknown graph format %q", name, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	if ext == ".gr" {
+		coordinates := strings.TrimSuffix(name, filepath.Ext(name)) + ".co"
+		co, err := os.Open(coordinates)
+		if os.IsNotExist(err) {
+			return graph, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer co.Close()
+		if err := ReadDIMACSCoordinates(graph, co); err != nil {
+			return nil, fmt.Errorf("%s: %w", coordinates, err)
+		}
+	}
+
+	return graph, nil
+}
@@ -0,0 +1,70 @@
+package loader_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/shortest_path/loader"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ shortest_path.ReverseGraph[string, *loader.Edge, int] = loader.NewGraph()
+
+func writeTestFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func Test_Graph_TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	graph, err := loader.LoadFile(writeTestFile(t, dir, "roads.csv", "a,b,1\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, graph.EdgeCount())
+
+	graph, err = loader.LoadFile(writeTestFile(t, dir, "roads.gv", "digraph { a -> b -> c }"))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, graph.EdgeCount())
+
+	writeTestFile(t, dir, "roads.co", "p aux sp co 4\nv 4 1.5 2\n")
+	graph, err = loader.LoadFile(writeTestFile(t, dir, "roads.gr", testDIMACS))
+	assert.NoError(t, err)
+	assert.Equal(t, 5, graph.EdgeCount())
+	x, _ := graph.Position("4")
+	assert.Equal(t, 1.5, x)
+
+	_, err = loader.LoadFile(writeTestFile(t, dir, "bad.csv", "a,b,1\na,b,x\n"))
+	assert.EqualError(t, err, filepath.Join(dir, "bad.csv")+`: line 2: cost "x" is not an integer`)
+
+	_, err = loader.LoadFile(writeTestFile(t, dir, "roads.txt", ""))
+	assert.Error(t, err)
+
+	_, err = loader.LoadFile(filepath.Join(dir, "missing.csv"))
+	assert.Error(t, err)
+}
+
+func Test_Graph_TestSearch(t *testing.T) {
+	graph, err := loader.ReadDIMACS(strings.NewReader(testDIMACS))
+	assert.NoError(t, err)
+
+	path := shortest_path.NewUniformCost[string, *loader.Edge](graph).Find("1", "4")
+	assert.True(t, path.Found)
+	assert.Equal(t, 8, path.Cost)
+	assert.Equal(t, []string{"1", "3", "2", "4"}, path.Vertices)
+
+	path = shortest_path.NewBidirectional[string, *loader.Edge](graph).Find("1", "4")
+	assert.Equal(t, 8, path.Cost)
+
+	uc := shortest_path.NewUniformCostByFunc(graph.ByFuncEdges, graph.ByFuncEdgeEnd, graph.ByFuncEdgeCost)
+	result := uc.Find("1", "4")
+	assert.True(t, result.Found)
+	assert.Equal(t, 8, result.Cost)
+	assert.Equal(t, []interface{}{"1", "3", "2", "4"}, result.Path)
+
+	bi := shortest_path.NewBidirectionalByFunc(graph.ByFuncEdges, graph.ByFuncEdgeEnd, graph.ByFuncEdgeCost, graph.ByFuncInEdges, graph.ByFuncEdgeStart)
+	assert.Equal(t, 8, bi.Find("1", "4").Cost)
+}
@@ -0,0 +1,54 @@
+package shortest_path
+
+// FindNearest finds the cheapest path from any of the sources to any vertex
+// for which goal returns true, Source and Goal of the path tell which were
+// used. Heuristics and bidirectional search need a single target, so they are
+// not used
+func (s *Search[V, E, C]) FindNearest(sources []V, goal func(vertex V) bool) (path *Path[V, E, C]) {
+	probe := s.newProbe()
+	defer func() {
+		path.Stats = probe.stats
+	}()
+
+	if s.bellmanFord {
+		return s.findNearestBellmanFord(sources, goal, probe)
+	}
+
+	noEstimate := func(V) C {
+		var zero C
+		return zero
+	}
+	found, err := s.expand(sources, noEstimate, nil, probe, func(n *node[V, E, C]) bool {
+		return goal(n.vertex)
+	})
+	if err != nil {
+		return &Path[V, E, C]{Found: false, Err: err}
+	}
+	if found == nil {
+		return &Path[V, E, C]{Found: false}
+	}
+
+	return found.toPath()
+}
+
+// findNearestBellmanFord relaxes every reachable vertex, costs may decrease
+// until the end so the cheapest goal is only known then. Of goals that cost
+// the same, the first reached wins
+func (s *Search[V, E, C]) findNearestBellmanFord(sources []V, goal func(vertex V) bool, probe *probe[V, E, C]) *Path[V, E, C] {
+	best, reached, err := s.relaxAll(sources, nil, probe)
+	if err != nil {
+		return &Path[V, E, C]{Found: false, Err: err}
+	}
+
+	var nearest *node[V, E, C]
+	for _, vertex := range reached {
+		if n := best[vertex]; goal(vertex) && (nearest == nil || s.costs.less(n.totalCost, nearest.totalCost)) {
+			nearest = n
+		}
+	}
+	if nearest == nil {
+		return &Path[V, E, C]{Found: false}
+	}
+
+	return nearest.toPath()
+}
@@ -0,0 +1,124 @@
+package shortest_path_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Nearest_TestMultipleSources(t *testing.T) {
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](newTestTypedGraph())
+
+	actual := uc.FindNearest([]string{"b", "f"}, func(vertex string) bool {
+		return vertex == "e" || vertex == "g"
+	})
+	assert.True(t, actual.Found)
+	assert.Equal(t, 3, actual.Cost)
+	assert.Equal(t, []string{"f", "g"}, actual.Vertices)
+	assert.Equal(t, "f", actual.Source())
+	assert.Equal(t, "g", actual.Goal())
+
+	actual = uc.FindNearest([]string{"c", "a"}, func(vertex string) bool {
+		return vertex == "e"
+	})
+	assert.True(t, actual.Found)
+	assert.Equal(t, 5, actual.Cost)
+	assert.Equal(t, "a", actual.Source())
+}
+
+func Test_Nearest_TestSourceIsGoal(t *testing.T) {
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](newTestTypedGraph())
+
+	actual := uc.FindNearest([]string{"a", "e"}, func(vertex string) bool {
+		return vertex == "e"
+	})
+	assert.True(t, actual.Found)
+	assert.Equal(t, 0, actual.Cost)
+	assert.Equal(t, []string{"e"}, actual.Vertices)
+}
+
+func Test_Nearest_TestNotFound(t *testing.T) {
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](newTestTypedGraph())
+
+	actual := uc.FindNearest([]string{"a"}, func(vertex string) bool {
+		return vertex == "h"
+	})
+	assert.False(t, actual.Found)
+	assert.Equal(t, "", actual.Source())
+
+	actual = uc.FindNearest(nil, func(vertex string) bool {
+		return true
+	})
+	assert.False(t, actual.Found)
+}
+
+func Test_Nearest_TestBellmanFord(t *testing.T) {
+	bf := shortest_path.NewBellmanFord[string, *testTypedEdge](newTestNegativeGraph())
+
+	actual := bf.FindNearest([]string{"a"}, func(vertex string) bool {
+		return vertex == "b" || vertex == "d"
+	})
+	assert.True(t, actual.Found)
+	assert.Equal(t, -1, actual.Cost)
+	assert.Equal(t, []string{"a", "c", "b"}, actual.Vertices)
+}
+
+func Test_Nearest_TestEqualCostGoals(t *testing.T) {
+	graph := &testTypedGraph{edges: map[string][]*testTypedEdge{}}
+	graph.addEdge("a", "x", 2).addEdge("a", "c", 1)
+	graph.addEdge("b", "y", 2)
+	graph.addEdge("c", "z", 1)
+	goal := func(vertex string) bool {
+		return vertex == "x" || vertex == "y" || vertex == "z"
+	}
+
+	// x, y and z all cost 2, Bellman-Ford picks the first reached every time
+	bf := shortest_path.NewBellmanFord[string, *testTypedEdge](graph)
+	for i := 0; i < 20; i++ {
+		actual := bf.FindNearest([]string{"a", "b"}, goal)
+		assert.True(t, actual.Found)
+		assert.Equal(t, 2, actual.Cost)
+		assert.Equal(t, []string{"a", "x"}, actual.Vertices)
+	}
+
+	// uniform cost picks the first settled
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](graph)
+	expected := uc.FindNearest([]string{"a", "b"}, goal)
+	assert.Equal(t, 2, expected.Cost)
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, expected.Vertices, uc.FindNearest([]string{"a", "b"}, goal).Vertices)
+	}
+}
+
+func Test_NearestByFunc_TestMultipleSources(t *testing.T) {
+	graph := &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+	graph.buildTestByFuncGraph()
+
+	uc := shortest_path.NewUniformCostByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost)
+
+	actual := uc.FindNearest([]interface{}{"b", nil, "f"}, func(vertex interface{}) bool {
+		return vertex == "e" || vertex == "g"
+	})
+	assert.True(t, actual.Found)
+	assert.Equal(t, 3, actual.Cost)
+	assert.Equal(t, "f,g", ByFuncString(actual.Path))
+	assert.Equal(t, "f", actual.Source)
+	assert.Equal(t, "g", actual.Goal)
+}
+
+func Test_NearestByInterface_TestMultipleSources(t *testing.T) {
+	graph := &testByInterfaceGraph{}
+	graph.buildTestByInterfaceGraph()
+
+	uc := shortest_path.NewUniformCostByInterface().(shortest_path.NearestFinder)
+
+	actual := uc.FindNearest([]interface{}{graph.vs["b"], "not a vertex", graph.vs["f"]}, func(vertex interface{}) bool {
+		return vertex == graph.vs["e"] || vertex == graph.vs["g"]
+	})
+	assert.True(t, actual.Found)
+	assert.Equal(t, 3, actual.Cost)
+	assert.Equal(t, "f,g", ByInterfaceString(actual.Path))
+	assert.Equal(t, graph.vs["f"], actual.Source)
+	assert.Equal(t, graph.vs["g"], actual.Goal)
+}
@@ -0,0 +1,95 @@
+package shortest_path
+
+// Observer is told about every step of a search. Costs are from the source,
+// except on the backward frontier of a bidirectional search where they are
+// to the target
+type Observer[V comparable, E any, C any] interface {
+	// Pushed is called when vertex is added to the queue
+	Pushed(vertex V, cost C)
+	// Popped is called when vertex leaves the queue
+	Popped(vertex V, cost C)
+	// Settled is called before the edges of vertex are relaxed, Bellman-Ford
+	// searches may settle a vertex more than once
+	Settled(vertex V, cost C)
+	// Relaxed is called for every edge from -> to considered, improved is
+	// true if it is the cheapest path to to found so far
+	Relaxed(from, to V, edge E, cost C, improved bool)
+}
+
+// Stats summarise the work done by a search
+type Stats struct {
+	// Expansions is the number of vertices settled
+	Expansions int
+	// Pushes is the number of vertices added to the queue
+	Pushes int
+	// PeakQueue is the largest number of vertices queued at once
+	PeakQueue int
+}
+
+// WithObserver returns a copy of the search that reports its steps to observer
+func (s *Search[V, E, C]) WithObserver(observer Observer[V, E, C]) *Search[V, E, C] {
+	observed := *s
+	observed.observer = observer
+	return &observed
+}
+
+// probe collects the Stats of one search and forwards steps to the observer, if any
+type probe[V comparable, E any, C any] struct {
+	observer Observer[V, E, C]
+	stats    Stats
+}
+
+func (s *Search[V, E, C]) newProbe() *probe[V, E, C] {
+	return &probe[V, E, C]{observer: s.observer}
+}
+
+// pushed is called after n is pushed, queued is the size of the queue
+func (p *probe[V, E, C]) pushed(n *node[V, E, C], queued int) {
+	p.stats.Pushes++
+	if queued > p.stats.PeakQueue {
+		p.stats.PeakQueue = queued
+	}
+	if p.observer != nil {
+		p.observer.Pushed(n.vertex, n.totalCost)
+	}
+}
+
+func (p *probe[V, E, C]) popped(n *node[V, E, C]) {
+	if p.observer != nil {
+		p.observer.Popped(n.vertex, n.totalCost)
+	}
+}
+
+func (p *probe[V, E, C]) settled(n *node[V, E, C]) {
+	p.stats.Expansions++
+	if p.observer != nil {
+		p.observer.Settled(n.vertex, n.totalCost)
+	}
+}
+
+func (p *probe[V, E, C]) relaxed(n *node[V, E, C], end V, edge E, cost C, improved bool) {
+	if p.observer != nil {
+		p.observer.Relaxed(n.vertex, end, edge, cost, improved)
+	}
+}
+
+// untypedObserver adapts an Observer of the untyped API to a typed search
+type untypedObserver[V comparable, E any] struct {
+	observer Observer[interface{}, interface{}, int]
+}
+
+func (o *untypedObserver[V, E]) Pushed(vertex V, cost int) {
+	o.observer.Pushed(vertex, cost)
+}
+
+func (o *untypedObserver[V, E]) Popped(vertex V, cost int) {
+	o.observer.Popped(vertex, cost)
+}
+
+func (o *untypedObserver[V, E]) Settled(vertex V, cost int) {
+	o.observer.Settled(vertex, cost)
+}
+
+func (o *untypedObserver[V, E]) Relaxed(from, to V, edge E, cost int, improved bool) {
+	o.observer.Relaxed(from, to, edge, cost, improved)
+}
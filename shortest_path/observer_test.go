@@ -0,0 +1,92 @@
+package shortest_path_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testCountingObserver struct {
+	pushed, popped, settled, relaxed, improved int
+}
+
+func (o *testCountingObserver) Pushed(vertex interface{}, cost int)  { o.pushed++ }
+func (o *testCountingObserver) Popped(vertex interface{}, cost int)  { o.popped++ }
+func (o *testCountingObserver) Settled(vertex interface{}, cost int) { o.settled++ }
+func (o *testCountingObserver) Relaxed(from, to interface{}, edge interface{}, cost int, improved bool) {
+	o.relaxed++
+	if improved {
+		o.improved++
+	}
+}
+
+func Test_Observer_TestStats(t *testing.T) {
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](newTestTypedGraph())
+
+	actual := uc.Find("a", "g")
+	assert.True(t, actual.Found)
+	assert.Equal(t, shortest_path.Stats{Expansions: 6, Pushes: 7, PeakQueue: 3}, actual.Stats)
+
+	actual = uc.Find("a", "h")
+	assert.False(t, actual.Found)
+	assert.Equal(t, 7, actual.Stats.Expansions)
+
+	tree := uc.FindAll("a")
+	assert.Equal(t, 7, tree.Stats.Expansions)
+}
+
+func Test_Observer_TestCallbacks(t *testing.T) {
+	recorder := &shortest_path.Recorder[string, *testTypedEdge, int]{}
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](newTestTypedGraph())
+	observed := uc.WithObserver(recorder)
+
+	actual := observed.Find("a", "g")
+	assert.True(t, actual.Found)
+
+	counts := map[string]int{}
+	for _, event := range recorder.Events {
+		counts[event.Kind]++
+	}
+	assert.Equal(t, actual.Stats.Pushes, counts[shortest_path.EventPushed])
+	assert.Equal(t, actual.Stats.Expansions, counts[shortest_path.EventSettled])
+	// the goal is popped but not settled
+	assert.Equal(t, actual.Stats.Expansions+1, counts[shortest_path.EventPopped])
+	assert.Equal(t, []string{"a", "d"}, recorder.Expanded()[:2])
+
+	// the original search is not observed
+	recorder.Reset()
+	uc.Find("a", "g")
+	assert.Empty(t, recorder.Events)
+}
+
+func Test_ObserverByFunc_TestCallbacks(t *testing.T) {
+	graph := &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+	graph.buildTestByFuncGraph()
+
+	observer := &testCountingObserver{}
+	uc := shortest_path.NewUniformCostByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost).WithObserver(observer)
+
+	actual := uc.Find("a", "g")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 6, actual.Stats.Expansions)
+	assert.Equal(t, 7, observer.pushed)
+	assert.Equal(t, 6, observer.settled)
+	assert.Equal(t, 7, observer.popped)
+	assert.Equal(t, 9, observer.relaxed)
+}
+
+func Test_ObserverByInterface_TestCallbacks(t *testing.T) {
+	graph := &testByInterfaceGraph{}
+	graph.buildTestByInterfaceGraph()
+
+	observer := &testCountingObserver{}
+	uc := shortest_path.NewUniformCostByInterface().(shortest_path.Observable).WithObserver(observer)
+
+	actual := uc.Find(graph.vs["a"], graph.vs["g"])
+	assert.True(t, actual.Found)
+	assert.Equal(t, 6, actual.Stats.Expansions)
+	assert.Equal(t, 7, actual.Stats.Pushes)
+	assert.Equal(t, 7, observer.pushed)
+	assert.Equal(t, 6, observer.settled)
+}
@@ -2,9 +2,14 @@
 
 package shortest_path
 
+import (
+	"container/heap"
+)
+
 type Item struct {
 	value    interface{}
 	priority PriorityFunc
+	less     LessFunc
 	index    int
 }
 
@@ -15,6 +20,9 @@ func (item *Item) Value() interface{} {
 type PriorityQueue []*Item
 type PriorityFunc func() int
 
+// LessFunc returns true if value must be popped before other, for priorities that are not int
+type LessFunc func(value, other interface{}) bool
+
 func NewItem(value interface{}, priority PriorityFunc) *Item {
 	return &Item{
 		value:    value,
@@ -30,9 +38,21 @@ func NewInitialItem(value interface{}, priority PriorityFunc, index int) *Item {
 	}
 }
 
+// NewLessItem creates an item ordered by less instead of a priority
+func NewLessItem(value interface{}, less LessFunc) *Item {
+	return &Item{
+		value: value,
+		less:  less,
+	}
+}
+
 func (pq PriorityQueue) Len() int { return len(pq) }
 
 func (pq PriorityQueue) Less(i, j int) bool {
+	if pq[i].less != nil {
+		return pq[i].less(pq[i].value, pq[j].value)
+	}
+
 	// Pop lowest value first
 	return pq[i].priority() < pq[j].priority()
 }
@@ -59,3 +79,26 @@ func (pq *PriorityQueue) Pop() interface{} {
 	*pq = old[0 : n-1]
 	return item
 }
+
+// Update the value and priority of an item already in the queue
+func (pq *PriorityQueue) Update(item *Item, value interface{}, priority PriorityFunc) {
+	item.value = value
+	item.priority = priority
+	heap.Fix(pq, item.index)
+}
+
+// UpdateValue changes the value of an item already in the queue, keeping how it is ordered
+func (pq *PriorityQueue) UpdateValue(item *Item, value interface{}) {
+	item.value = value
+	heap.Fix(pq, item.index)
+}
+
+// Fix restores the queue order after the priority of item has changed
+func (pq *PriorityQueue) Fix(item *Item) {
+	heap.Fix(pq, item.index)
+}
+
+// Remove item from the queue
+func (pq *PriorityQueue) Remove(item *Item) {
+	heap.Remove(pq, item.index)
+}
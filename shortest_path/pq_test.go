@@ -92,3 +92,32 @@ func Test_PQ(t *testing.T) {
 		assert.Equal(t, tt.expect, actual)
 	}
 }
+
+func Test_PQ_TestUpdateFixRemove(t *testing.T) {
+	items := []*TestItem{createTestItem(5), createTestItem(3), createTestItem(8), createTestItem(1)}
+
+	pq := make(shortest_path.PriorityQueue, 0)
+	queued := make([]*shortest_path.Item, len(items))
+	for i, ti := range items {
+		queued[i] = shortest_path.NewItem(ti, ti.Priority)
+		heap.Push(&pq, queued[i])
+	}
+
+	// decrease 8 to 2
+	updated := createTestItem(2)
+	pq.Update(queued[2], updated, updated.Priority)
+
+	// increase 1 to 9 in place
+	items[3].priority = 9
+	pq.Fix(queued[3])
+
+	pq.Remove(queued[0])
+
+	actual := make([]*TestItem, 0)
+	for pq.Len() > 0 {
+		item := heap.Pop(&pq).(*shortest_path.Item)
+		actual = append(actual, item.Value().(*TestItem))
+	}
+
+	assert.Equal(t, []*TestItem{createTestItem(2), createTestItem(3), createTestItem(9)}, actual)
+}
@@ -0,0 +1,72 @@
+package shortest_path
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Kinds of recorded events
+const (
+	EventPushed  = "pushed"
+	EventPopped  = "popped"
+	EventSettled = "settled"
+	EventRelaxed = "relaxed"
+)
+
+// Event is one step of a search recorded by a Recorder
+type Event[V comparable, C any] struct {
+	Kind   string `json:"kind"`
+	Vertex V      `json:"vertex"`
+	// From is the start of a relaxed edge, Vertex is its end
+	From     *V   `json:"from,omitempty"`
+	Cost     C    `json:"cost"`
+	Improved bool `json:"improved,omitempty"`
+}
+
+// Recorder is an Observer that keeps every step in order, to replay or
+// animate a search. The zero value is ready to use
+type Recorder[V comparable, E any, C any] struct {
+	Events []Event[V, C]
+}
+
+func (r *Recorder[V, E, C]) Pushed(vertex V, cost C) {
+	r.Events = append(r.Events, Event[V, C]{Kind: EventPushed, Vertex: vertex, Cost: cost})
+}
+
+func (r *Recorder[V, E, C]) Popped(vertex V, cost C) {
+	r.Events = append(r.Events, Event[V, C]{Kind: EventPopped, Vertex: vertex, Cost: cost})
+}
+
+func (r *Recorder[V, E, C]) Settled(vertex V, cost C) {
+	r.Events = append(r.Events, Event[V, C]{Kind: EventSettled, Vertex: vertex, Cost: cost})
+}
+
+func (r *Recorder[V, E, C]) Relaxed(from, to V, edge E, cost C, improved bool) {
+	r.Events = append(r.Events, Event[V, C]{Kind: EventRelaxed, Vertex: to, From: &from, Cost: cost, Improved: improved})
+}
+
+// Expanded returns the settled vertices in the order they were expanded
+func (r *Recorder[V, E, C]) Expanded() []V {
+	expanded := []V{}
+	for _, event := range r.Events {
+		if event.Kind == EventSettled {
+			expanded = append(expanded, event.Vertex)
+		}
+	}
+	return expanded
+}
+
+// WriteJSON writes the events as a JSON array, vertices and costs are encoded
+// with encoding/json
+func (r *Recorder[V, E, C]) WriteJSON(w io.Writer) error {
+	events := r.Events
+	if events == nil {
+		events = []Event[V, C]{}
+	}
+	return json.NewEncoder(w).Encode(events)
+}
+
+// Reset drops the recorded events
+func (r *Recorder[V, E, C]) Reset() {
+	r.Events = nil
+}
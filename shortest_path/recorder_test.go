@@ -0,0 +1,38 @@
+package shortest_path_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fatdes/go_algo/shortest_path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Recorder_TestWriteJSON(t *testing.T) {
+	graph := &testTypedGraph{edges: map[string][]*testTypedEdge{}}
+	graph.addEdge("a", "b", 1)
+
+	recorder := &shortest_path.Recorder[string, *testTypedEdge, int]{}
+	buf := &bytes.Buffer{}
+	assert.NoError(t, recorder.WriteJSON(buf))
+	assert.JSONEq(t, "[]", buf.String())
+
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](graph).WithObserver(recorder)
+	uc.Find("a", "b")
+
+	buf.Reset()
+	assert.NoError(t, recorder.WriteJSON(buf))
+	assert.JSONEq(t, `[
+		{"kind": "pushed", "vertex": "a", "cost": 0},
+		{"kind": "popped", "vertex": "a", "cost": 0},
+		{"kind": "settled", "vertex": "a", "cost": 0},
+		{"kind": "relaxed", "vertex": "b", "from": "a", "cost": 1, "improved": true},
+		{"kind": "pushed", "vertex": "b", "cost": 1},
+		{"kind": "popped", "vertex": "b", "cost": 1}
+	]`, buf.String())
+
+	events := []shortest_path.Event[string, int]{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &events))
+	assert.Equal(t, recorder.Events, events)
+}
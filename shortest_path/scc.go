@@ -0,0 +1,349 @@
+package shortest_path
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Components are the strongly connected components of a graph, the largest
+// sets of vertices that can all reach each other
+type Components[V comparable, E any, C any] struct {
+	// Vertices of every component, in topological order: edges between two
+	// components go from a lower index to a higher one
+	Vertices [][]V
+
+	graph     Graph[V, E, C]
+	component map[V]int
+}
+
+// StronglyConnected finds the components of the vertices reachable from
+// vertices with Tarjan's algorithm
+func StronglyConnected[V comparable, E any, C any](graph Graph[V, E, C], vertices []V) *Components[V, E, C] {
+	type frame struct {
+		vertex V
+		edges  []E
+		next   int
+	}
+
+	// index numbers the vertices in the order they are found, low is the
+	// lowest index reachable through the vertices still on the stack
+	index := map[V]int{}
+	low := map[V]int{}
+	onStack := map[V]bool{}
+	stack := []V{}
+	// finished holds the components in reverse topological order
+	finished := [][]V{}
+
+	visit := func(frames []*frame, v V) []*frame {
+		index[v] = len(index)
+		low[v] = index[v]
+		onStack[v] = true
+		stack = append(stack, v)
+		return append(frames, &frame{vertex: v, edges: graph.Edges(v)})
+	}
+
+	for _, root := range vertices {
+		if _, found := index[root]; found {
+			continue
+		}
+		frames := visit(nil, root)
+
+		for len(frames) > 0 {
+			top := frames[len(frames)-1]
+			if top.next < len(top.edges) {
+				end := graph.EdgeEnd(top.edges[top.next])
+				top.next++
+				if _, found := index[end]; !found {
+					frames = visit(frames, end)
+				} else if onStack[end] && index[end] < low[top.vertex] {
+					low[top.vertex] = index[end]
+				}
+				continue
+			}
+
+			frames = frames[:len(frames)-1]
+			if low[top.vertex] == index[top.vertex] {
+				// top is the first vertex found of its component, the rest is above it on the stack
+				i := len(stack) - 1
+				for stack[i] != top.vertex {
+					i--
+				}
+				component := append([]V{}, stack[i:]...)
+				for _, v := range component {
+					onStack[v] = false
+				}
+				stack = stack[:i]
+				finished = append(finished, component)
+			}
+			if len(frames) > 0 {
+				if parent := frames[len(frames)-1].vertex; low[top.vertex] < low[parent] {
+					low[parent] = low[top.vertex]
+				}
+			}
+		}
+	}
+
+	c := &Components[V, E, C]{
+		Vertices:  make([][]V, len(finished)),
+		graph:     graph,
+		component: make(map[V]int, len(index)),
+	}
+	for i, component := range finished {
+		id := len(finished) - 1 - i
+		c.Vertices[id] = component
+		for _, v := range component {
+			c.component[v] = id
+		}
+	}
+	return c
+}
+
+// StronglyConnectedByFunc finds the components over the untyped adjacency
+// functions of NewUniformCostByFunc
+func StronglyConnectedByFunc(vertices []interface{}, edges edges, edgeEnd edgeEnd, edgeCost edgeCost) *Components[interface{}, interface{}, int] {
+	return StronglyConnected(NewFuncGraph[interface{}, interface{}, int](edges, edgeEnd, edgeCost), vertices)
+}
+
+// StronglyConnectedByInterface finds the components over the Vertex and Edge interfaces
+func StronglyConnectedByInterface(vertices ...Vertex) *Components[Vertex, Edge, int] {
+	return StronglyConnected(NewInterfaceGraph(), vertices)
+}
+
+// Component returns the index of the component of v, false if v was not reached
+func (c *Components[V, E, C]) Component(v V) (int, bool) {
+	id, found := c.component[v]
+	return id, found
+}
+
+// Condensation returns the acyclic graph of the components, vertices are the
+// indexes of Vertices. Its edges are the edges of the graph between two
+// components, they end at the component of their end
+func (c *Components[V, E, C]) Condensation() Graph[int, E, C] {
+	return &condensation[V, E, C]{components: c}
+}
+
+// condensation adapts the graph of Components to their indexes
+type condensation[V comparable, E any, C any] struct {
+	components *Components[V, E, C]
+}
+
+func (g *condensation[V, E, C]) Edges(id int) []E {
+	c := g.components
+	if id < 0 || id >= len(c.Vertices) {
+		return []E{}
+	}
+	edges := []E{}
+	for _, v := range c.Vertices[id] {
+		for _, edge := range c.graph.Edges(v) {
+			if c.component[c.graph.EdgeEnd(edge)] != id {
+				edges = append(edges, edge)
+			}
+		}
+	}
+	return edges
+}
+
+func (g *condensation[V, E, C]) EdgeEnd(edge E) int {
+	return g.components.component[g.components.graph.EdgeEnd(edge)]
+}
+
+func (g *condensation[V, E, C]) EdgeCost(edge E) C {
+	return g.components.graph.EdgeCost(edge)
+}
+
+// Reachable returns the set of vertices reachable from sources, sources included
+func Reachable[V comparable, E any, C any](graph Graph[V, E, C], sources []V) map[V]bool {
+	reached := map[V]bool{}
+	queue := []V{}
+	for _, source := range sources {
+		if !reached[source] {
+			reached[source] = true
+			queue = append(queue, source)
+		}
+	}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, edge := range graph.Edges(v) {
+			if end := graph.EdgeEnd(edge); !reached[end] {
+				reached[end] = true
+				queue = append(queue, end)
+			}
+		}
+	}
+	return reached
+}
+
+// CoReachable returns the set of vertices that reach any of targets, targets included
+func CoReachable[V comparable, E any, C any](graph ReverseGraph[V, E, C], targets []V) map[V]bool {
+	return Reachable[V, E, C](&reversed[V, E, C]{graph}, targets)
+}
+
+// ReachableByFunc is Reachable over the untyped adjacency functions of NewUniformCostByFunc
+func ReachableByFunc(sources []interface{}, edges edges, edgeEnd edgeEnd) map[interface{}]bool {
+	return Reachable(NewFuncGraph[interface{}, interface{}, int](edges, edgeEnd, nil), sources)
+}
+
+// CoReachableByFunc is CoReachable over the untyped reverse adjacency
+// functions of a ReverseByFunc
+func CoReachableByFunc(targets []interface{}, inEdges edges, edgeStart edgeEnd) map[interface{}]bool {
+	return Reachable(NewFuncGraph[interface{}, interface{}, int](inEdges, edgeStart, nil), targets)
+}
+
+// ReachableByInterface is Reachable over the Vertex and Edge interfaces
+func ReachableByInterface(sources ...Vertex) map[Vertex]bool {
+	return Reachable(NewInterfaceGraph(), sources)
+}
+
+// CoReachableByInterface is CoReachable over the Vertex and Edge interfaces,
+// vertices that do not implement InVertex are not walked past
+func CoReachableByInterface(targets ...InVertex) map[Vertex]bool {
+	vertices := make([]Vertex, len(targets))
+	for i, target := range targets {
+		vertices[i] = target
+	}
+	return CoReachable(NewReverseInterfaceGraph(), vertices)
+}
+
+// Diagnosis explains why a search from From found no path to To
+type Diagnosis[V comparable] struct {
+	From, To V
+
+	// Reachable is true if there is a path from -> to, a search that found
+	// none was stopped by its limits or failed
+	Reachable bool
+
+	// Source is the component of From, Target the component of To
+	Source []V
+	Target []V
+
+	// DeadEnds are the components reachable from From that no edge leaves,
+	// every path from From ends in one of them. Nil when To is reachable
+	DeadEnds [][]V
+	// Entries are the components reaching To that no edge enters, every path
+	// to To starts in one of them. Nil when To is reachable or the search
+	// cannot walk backwards, also from some of the vertices reaching To
+	Entries [][]V
+}
+
+func (d *Diagnosis[V]) String() string {
+	if d.Reachable {
+		return fmt.Sprintf("%v is reachable from %v", d.To, d.From)
+	}
+
+	describe := func(components [][]V) string {
+		descriptions := make([]string, len(components))
+		for i, component := range components {
+			descriptions[i] = fmt.Sprintf("%v", component)
+		}
+		return strings.Join(descriptions, ", ")
+	}
+	s := fmt.Sprintf("%v is unreachable from %v: paths from component %v end in %s", d.To, d.From, d.Source, describe(d.DeadEnds))
+	if d.Entries != nil {
+		s += fmt.Sprintf(", paths to component %v start in %s", d.Target, describe(d.Entries))
+	}
+	return s
+}
+
+// Diagnose tells whether to is reachable from from, and if not which
+// component boundaries separate them. It walks backwards from to when the
+// search is bidirectional
+func (s *Search[V, E, C]) Diagnose(from, to V) *Diagnosis[V] {
+	forward := StronglyConnected(s.graph, []V{from})
+	d := &Diagnosis[V]{
+		From:   from,
+		To:     to,
+		Source: forward.Vertices[0],
+	}
+	target := StronglyConnected(s.graph, []V{to})
+	d.Target = target.Vertices[0]
+	if _, found := forward.Component(to); found {
+		d.Reachable = true
+		return d
+	}
+
+	d.DeadEnds = [][]V{}
+	condensed := forward.Condensation()
+	for id, component := range forward.Vertices {
+		if len(condensed.Edges(id)) == 0 {
+			d.DeadEnds = append(d.DeadEnds, component)
+		}
+	}
+
+	if s.reverse != nil {
+		// the components are the same backwards, the ones without in-edges are dead ends there
+		backward := StronglyConnected[V, E, C](&reversed[V, E, C]{s.reverse}, []V{to})
+		if !backward.complete(s.reverse) {
+			return d
+		}
+		condensed := backward.Condensation()
+		d.Entries = [][]V{}
+		for id, component := range backward.Vertices {
+			if len(condensed.Edges(id)) == 0 {
+				d.Entries = append(d.Entries, component)
+			}
+		}
+	}
+	return d
+}
+
+// complete returns false if the in-edges of a vertex of c are unknown in reverse
+func (c *Components[V, E, C]) complete(reverse ReverseGraph[V, E, C]) bool {
+	partial, ok := reverse.(partialReverse[V])
+	if !ok {
+		return true
+	}
+	for v := range c.component {
+		if !partial.hasInEdges(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diagnoser is implemented by the UniformCost of the ByFunc and ByInterface
+// constructors, to explain a Result that was not found
+type Diagnoser interface {
+	Diagnose(from, to interface{}) *Diagnosis[interface{}]
+}
+
+// Diagnose explains a search from -> to, it returns nil if from or to is nil
+func (b *byFunc) Diagnose(from interface{}, to interface{}) *Diagnosis[interface{}] {
+	if from == nil || to == nil {
+		return nil
+	}
+
+	return b.search.Diagnose(from, to)
+}
+
+// Diagnose explains a search from -> to, it returns nil if from or to is not a Vertex
+func (b *byInterface) Diagnose(from interface{}, to interface{}) *Diagnosis[interface{}] {
+	fromVertex, toVertex, ok := vertices(from, to)
+	if !ok {
+		return nil
+	}
+
+	d := b.searchTo(toVertex).Diagnose(fromVertex, toVertex)
+	untyped := func(components [][]Vertex) [][]interface{} {
+		if components == nil {
+			return nil
+		}
+		converted := make([][]interface{}, len(components))
+		for i, component := range components {
+			converted[i] = make([]interface{}, len(component))
+			for j, v := range component {
+				converted[i][j] = v
+			}
+		}
+		return converted
+	}
+	return &Diagnosis[interface{}]{
+		From:      from,
+		To:        to,
+		Reachable: d.Reachable,
+		Source:    untyped([][]Vertex{d.Source})[0],
+		Target:    untyped([][]Vertex{d.Target})[0],
+		DeadEnds:  untyped(d.DeadEnds),
+		Entries:   untyped(d.Entries),
+	}
+}
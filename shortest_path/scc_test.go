@@ -0,0 +1,132 @@
+package shortest_path_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StronglyConnected_TestComponents(t *testing.T) {
+	components := shortest_path.StronglyConnected[string, *testTypedEdge, int](newTestTypedGraph(), []string{"a"})
+	assert.Equal(t, [][]string{{"a"}, {"d"}, {"f"}, {"e", "b", "c", "g"}}, components.Vertices)
+
+	id, found := components.Component("g")
+	assert.True(t, found)
+	assert.Equal(t, 3, id)
+	_, found = components.Component("h")
+	assert.False(t, found)
+
+	// starting from inside a component only finds what it reaches
+	components = shortest_path.StronglyConnected[string, *testTypedEdge, int](newTestTypedGraph(), []string{"c", "f"})
+	assert.Len(t, components.Vertices, 2)
+	assert.ElementsMatch(t, []string{"b", "c", "e", "g"}, components.Vertices[1])
+	assert.Equal(t, []string{"f"}, components.Vertices[0])
+}
+
+func Test_StronglyConnected_TestCondensation(t *testing.T) {
+	components := shortest_path.StronglyConnected[string, *testTypedEdge, int](newTestTypedGraph(), []string{"a"})
+	condensation := components.Condensation()
+
+	assert.Len(t, condensation.Edges(0), 2)
+	assert.Empty(t, condensation.Edges(3))
+	assert.Empty(t, condensation.Edges(4))
+	for id := range components.Vertices {
+		for _, edge := range condensation.Edges(id) {
+			assert.Greater(t, condensation.EdgeEnd(edge), id)
+		}
+	}
+
+	dag, err := shortest_path.NewDAG(condensation, []int{0})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3}, dag.Order)
+	assert.Equal(t, 5, dag.Find(0, 3).Cost)
+	assert.Equal(t, 8, dag.FindLongest(0, 3).Cost)
+}
+
+func Test_StronglyConnected_TestByFunc(t *testing.T) {
+	graph := &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+	graph.buildTestByFuncGraph()
+
+	components := shortest_path.StronglyConnectedByFunc([]interface{}{"a"}, graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost)
+	assert.Len(t, components.Vertices, 4)
+	assert.ElementsMatch(t, []interface{}{"b", "c", "e", "g"}, components.Vertices[3])
+
+	assert.Equal(t, map[interface{}]bool{"d": true, "e": true, "f": true, "b": true, "c": true, "g": true},
+		shortest_path.ReachableByFunc([]interface{}{"d"}, graph.getEdges, graph.getEdgeEnd))
+	assert.Equal(t, map[interface{}]bool{"f": true, "d": true, "a": true},
+		shortest_path.CoReachableByFunc([]interface{}{"f"}, graph.getInEdges, graph.getEdgeStart))
+}
+
+func Test_StronglyConnected_TestByInterface(t *testing.T) {
+	a, b, c := &testInVertex{id: "a"}, &testInVertex{id: "b"}, &testInVertex{id: "c"}
+	a.addEdge(b, 1)
+	b.addEdge(a, 1).addEdge(c, 1)
+
+	components := shortest_path.StronglyConnectedByInterface(a)
+	assert.Equal(t, [][]shortest_path.Vertex{{a, b}, {c}}, components.Vertices)
+
+	assert.Equal(t, map[shortest_path.Vertex]bool{c: true}, shortest_path.ReachableByInterface(c))
+	assert.Equal(t, map[shortest_path.Vertex]bool{a: true, b: true, c: true}, shortest_path.CoReachableByInterface(c))
+}
+
+func Test_Diagnose_TestByFunc(t *testing.T) {
+	graph := &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+	graph.buildTestByFuncGraph()
+	uc := shortest_path.NewUniformCostByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost)
+
+	diagnosis := uc.Diagnose("a", "g")
+	assert.True(t, diagnosis.Reachable)
+	assert.Equal(t, "g is reachable from a", diagnosis.String())
+
+	assert.False(t, uc.Find("f", "d").Found)
+	diagnosis = uc.Diagnose("f", "d")
+	assert.False(t, diagnosis.Reachable)
+	assert.Equal(t, []interface{}{"f"}, diagnosis.Source)
+	assert.Equal(t, []interface{}{"d"}, diagnosis.Target)
+	assert.Len(t, diagnosis.DeadEnds, 1)
+	assert.ElementsMatch(t, []interface{}{"b", "c", "e", "g"}, diagnosis.DeadEnds[0])
+	assert.Nil(t, diagnosis.Entries)
+	assert.Equal(t, "d is unreachable from f: paths from component [f] end in [g e b c]", diagnosis.String())
+
+	bi := shortest_path.NewBidirectionalByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost, graph.getInEdges, graph.getEdgeStart)
+	diagnosis = bi.Diagnose("f", "d")
+	assert.Equal(t, [][]interface{}{{"a"}}, diagnosis.Entries)
+	assert.Equal(t, "d is unreachable from f: paths from component [f] end in [g e b c], paths to component [d] start in [a]", diagnosis.String())
+
+	assert.Nil(t, uc.Diagnose(nil, "d"))
+}
+
+func Test_Diagnose_TestByInterface(t *testing.T) {
+	a, b, c := &testInVertex{id: "a"}, &testInVertex{id: "b"}, &testInVertex{id: "c"}
+	a.addEdge(b, 1)
+	b.addEdge(a, 1).addEdge(c, 1)
+
+	var uc shortest_path.UniformCost = shortest_path.NewBidirectionalByInterface()
+	assert.False(t, uc.Find(c, a).Found)
+	diagnoser, ok := uc.(shortest_path.Diagnoser)
+	assert.True(t, ok)
+
+	diagnosis := diagnoser.Diagnose(c, a)
+	assert.False(t, diagnosis.Reachable)
+	assert.Equal(t, []interface{}{c}, diagnosis.Source)
+	assert.Equal(t, []interface{}{a, b}, diagnosis.Target)
+	assert.Equal(t, [][]interface{}{{c}}, diagnosis.DeadEnds)
+	assert.Equal(t, [][]interface{}{{a, b}}, diagnosis.Entries)
+
+	assert.True(t, diagnoser.Diagnose(a, c).Reachable)
+	assert.Nil(t, diagnoser.Diagnose("a", c))
+
+	// without InVertex targets the search cannot walk backwards
+	graph := &testByInterfaceGraph{}
+	graph.buildTestByInterfaceGraph()
+	diagnosis = shortest_path.NewUniformCostByInterface().(shortest_path.Diagnoser).Diagnose(graph.vs["g"], graph.vs["a"])
+	assert.False(t, diagnosis.Reachable)
+	assert.Nil(t, diagnosis.Entries)
+
+	// nor past a vertex that does not implement InVertex
+	p := &testOutVertex{id: "p"}
+	linkTestMixed(p, a, 1)
+	diagnosis = diagnoser.Diagnose(c, a)
+	assert.Nil(t, diagnosis.Entries)
+}
@@ -0,0 +1,311 @@
+package shortest_path
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+)
+
+// ErrNegativeCost is reported by searches that cannot handle negative edge costs
+var ErrNegativeCost = errors.New("negative edge cost")
+
+// Path is the typed result of a search, Edges[i] leads from Vertices[i] to Vertices[i+1]
+type Path[V comparable, E any, C any] struct {
+	Found bool
+
+	Cost     C
+	Vertices []V
+	Edges    []E
+
+	// Resources used by a FindConstrained path, in the order of Constraints.Resources
+	Resources []int
+
+	// Stats of the search, also when nothing is found
+	Stats Stats
+
+	// Err is set when the search could not complete, Found is false
+	Err error
+}
+
+// Source is the first vertex of a found path
+func (p *Path[V, E, C]) Source() V {
+	if !p.Found {
+		var zero V
+		return zero
+	}
+	return p.Vertices[0]
+}
+
+// Goal is the last vertex of a found path
+func (p *Path[V, E, C]) Goal() V {
+	if !p.Found {
+		var zero V
+		return zero
+	}
+	return p.Vertices[len(p.Vertices)-1]
+}
+
+// Search finds shortest paths over a typed Graph. It is safe for concurrent
+// use under the same conditions as UniformCost
+type Search[V comparable, E any, C any] struct {
+	graph     Graph[V, E, C]
+	heuristic Heuristic[V, C]
+	costs     costs[C]
+
+	// reverse is set for bidirectional searches
+	reverse ReverseGraph[V, E, C]
+
+	// bellmanFord is set for searches that accept negative edge costs
+	bellmanFord bool
+
+	// observer is set by WithObserver
+	observer Observer[V, E, C]
+}
+
+// NewUniformCost creates a uniform cost search over graph
+func NewUniformCost[V comparable, E any, C any](graph Graph[V, E, C]) *Search[V, E, C] {
+	return &Search[V, E, C]{
+		graph: graph,
+		costs: costsOf[C](),
+	}
+}
+
+// NewAStar creates an A* search over graph, ordered by cost so far plus the
+// heuristic estimate to the goal. A settled vertex is searched again when a
+// cheaper path reaches it, so the heuristic only has to never overestimate
+func NewAStar[V comparable, E any, C any](graph Graph[V, E, C], heuristic Heuristic[V, C]) *Search[V, E, C] {
+	if heuristic == nil {
+		panic("heuristic must not be nil")
+	}
+
+	return &Search[V, E, C]{
+		graph:     graph,
+		heuristic: heuristic,
+		costs:     costsOf[C](),
+	}
+}
+
+// node is a path ending at vertex, linked back to the source through parent
+type node[V comparable, E any, C any] struct {
+	vertex    V
+	totalCost C
+	estimate  C
+	// key orders the queue, cost so far plus estimated cost to goal
+	key C
+
+	parent *node[V, E, C]
+	// edge leads from parent to vertex
+	edge E
+	// depth is the number of edges from the source
+	depth int
+}
+
+func (s *Search[V, E, C]) estimate(vertex, goal V) C {
+	if s.heuristic == nil {
+		var zero C
+		return zero
+	}
+	return s.heuristic(vertex, goal)
+}
+
+// lessNode orders queued nodes by key
+func (s *Search[V, E, C]) lessNode(value, other interface{}) bool {
+	return s.costs.less(value.(*node[V, E, C]).key, other.(*node[V, E, C]).key)
+}
+
+// withEstimate sets the estimate and key of n
+func (s *Search[V, E, C]) withEstimate(n *node[V, E, C], estimate C) (*node[V, E, C], error) {
+	key, err := s.costs.sum(n.totalCost, estimate)
+	if err != nil {
+		return nil, err
+	}
+
+	n.estimate = estimate
+	n.key = key
+	return n, nil
+}
+
+// extend returns a node for end reached from n through edge
+func (n *node[V, E, C]) extend(end V, edge E, totalCost C) *node[V, E, C] {
+	return &node[V, E, C]{
+		vertex:    end,
+		totalCost: totalCost,
+		key:       totalCost,
+		parent:    n,
+		edge:      edge,
+		depth:     n.depth + 1,
+	}
+}
+
+// toPath follows the parents back to the source
+func (n *node[V, E, C]) toPath() *Path[V, E, C] {
+	vertices := make([]V, n.depth+1)
+	edges := make([]E, n.depth)
+	for current := n; current != nil; current = current.parent {
+		vertices[current.depth] = current.vertex
+		if current.parent != nil {
+			edges[current.depth-1] = current.edge
+		}
+	}
+
+	return &Path[V, E, C]{
+		Found: true,
+
+		Cost:     n.totalCost,
+		Vertices: vertices,
+		Edges:    edges,
+	}
+}
+
+// relax returns the cost of the path of n extended by edge
+func (s *Search[V, E, C]) relax(n *node[V, E, C], edge E, end V) (C, error) {
+	cost := s.graph.EdgeCost(edge)
+	if !s.bellmanFord && s.costs.negative(cost) {
+		return cost, fmt.Errorf("%w: %v -> %v costs %v", ErrNegativeCost, n.vertex, end, cost)
+	}
+
+	return s.costs.sum(n.totalCost, cost)
+}
+
+// Find the cheapest path from -> to
+func (s *Search[V, E, C]) Find(from, to V) *Path[V, E, C] {
+	return s.find(from, to, nil)
+}
+
+// find the cheapest path from -> to within limits, if any
+func (s *Search[V, E, C]) find(from, to V, limits *limiter[V, E, C]) (path *Path[V, E, C]) {
+	probe := s.newProbe()
+	defer func() {
+		path.Stats = probe.stats
+	}()
+
+	if s.bellmanFord {
+		return s.findBellmanFord(from, to, limits, probe)
+	}
+
+	if from == to {
+		return &Path[V, E, C]{
+			Found:    true,
+			Vertices: []V{from},
+			Edges:    []E{},
+		}
+	}
+
+	if s.reverse != nil {
+		return s.findBidirectional(from, to, limits, probe)
+	}
+	return s.findOneWay(from, to, limits, probe)
+}
+
+// findOneWay searches forwards from the source
+func (s *Search[V, E, C]) findOneWay(from, to V, limits *limiter[V, E, C], probe *probe[V, E, C]) *Path[V, E, C] {
+	estimate := func(vertex V) C {
+		return s.estimate(vertex, to)
+	}
+	found, err := s.expand([]V{from}, estimate, limits, probe, func(n *node[V, E, C]) bool {
+		return n.vertex == to
+	})
+	if err != nil {
+		return &Path[V, E, C]{Found: false, Err: err}
+	}
+	if found == nil {
+		return &Path[V, E, C]{Found: false}
+	}
+
+	return found.toPath()
+}
+
+// expand settles vertices from the sources in order of cost plus estimate
+// until settled returns true for one, which is returned
+func (s *Search[V, E, C]) expand(sources []V, estimate func(V) C, limits *limiter[V, E, C], probe *probe[V, E, C], settled func(n *node[V, E, C]) bool) (*node[V, E, C], error) {
+	less := s.lessNode
+	pq := make(PriorityQueue, 0, len(sources))
+
+	// every vertex is queued at most once, its item is updated when a cheaper path is found
+	queued := map[V]*Item{}
+	// explored holds the node a vertex was settled with
+	explored := map[V]*node[V, E, C]{}
+
+	for _, from := range sources {
+		if _, found := queued[from]; found {
+			continue
+		}
+		initialNode, err := s.withEstimate(&node[V, E, C]{vertex: from}, estimate(from))
+		if err != nil {
+			return nil, err
+		}
+		item := NewLessItem(
+			initialNode,
+			less,
+		)
+		heap.Push(&pq, item)
+		queued[from] = item
+		probe.pushed(initialNode, pq.Len())
+	}
+
+	for pq.Len() > 0 {
+		item := heap.Pop(&pq).(*Item)
+		n := item.value.(*node[V, E, C])
+		delete(queued, n.vertex)
+		explored[n.vertex] = n
+		probe.popped(n)
+
+		if settled(n) {
+			return n, nil
+		}
+
+		if err := limits.settle(n, n.key, true); err != nil {
+			return nil, err
+		}
+		probe.settled(n)
+
+		for _, edge := range s.graph.Edges(n.vertex) {
+			end := s.graph.EdgeEnd(edge)
+			totalCost, err := s.relax(n, edge, end)
+			if err != nil {
+				return nil, err
+			}
+
+			if closed, found := explored[end]; found {
+				// a heuristic that never overestimates but is not consistent can
+				// settle a vertex before its cheapest path, it is queued again
+				if s.heuristic == nil || !s.costs.less(totalCost, closed.totalCost) {
+					probe.relaxed(n, end, edge, totalCost, false)
+					continue
+				}
+				delete(explored, end)
+			}
+
+			if item, found := queued[end]; found {
+				current := item.value.(*node[V, E, C])
+				improved := s.costs.less(totalCost, current.totalCost)
+				probe.relaxed(n, end, edge, totalCost, improved)
+				if !improved {
+					continue
+				}
+				newNode, err := s.withEstimate(n.extend(end, edge, totalCost), current.estimate)
+				if err != nil {
+					return nil, err
+				}
+				pq.UpdateValue(item, newNode)
+				continue
+			}
+
+			probe.relaxed(n, end, edge, totalCost, true)
+
+			newNode, err := s.withEstimate(n.extend(end, edge, totalCost), estimate(end))
+			if err != nil {
+				return nil, err
+			}
+			item := NewLessItem(
+				newNode,
+				less,
+			)
+			heap.Push(&pq, item)
+			queued[end] = item
+			probe.pushed(newNode, pq.Len())
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,99 @@
+package shortest_path_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testTypedEdge struct {
+	from, to string
+	cost     int
+}
+
+type testTypedGraph struct {
+	edges map[string][]*testTypedEdge
+}
+
+func (graph *testTypedGraph) Edges(vertex string) []*testTypedEdge {
+	return graph.edges[vertex]
+}
+
+func (graph *testTypedGraph) EdgeEnd(edge *testTypedEdge) string {
+	return edge.to
+}
+
+func (graph *testTypedGraph) EdgeCost(edge *testTypedEdge) int {
+	return edge.cost
+}
+
+func (graph *testTypedGraph) addEdge(from, to string, cost int) *testTypedGraph {
+	graph.edges[from] = append(graph.edges[from], &testTypedEdge{from: from, to: to, cost: cost})
+	return graph
+}
+
+func newTestTypedGraph() *testTypedGraph {
+	graph := &testTypedGraph{edges: map[string][]*testTypedEdge{}}
+	graph.addEdge("a", "d", 3).addEdge("a", "b", 5)
+	graph.addEdge("b", "c", 1)
+	graph.addEdge("c", "e", 6).addEdge("c", "g", 8)
+	graph.addEdge("d", "e", 2).addEdge("d", "f", 2)
+	graph.addEdge("e", "b", 4)
+	graph.addEdge("f", "g", 3)
+	graph.addEdge("g", "e", 4)
+	return graph
+}
+
+func Test_Search_TestNotFound(t *testing.T) {
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](newTestTypedGraph())
+
+	actual := uc.Find("a", "h")
+	assert.NotNil(t, actual)
+	assert.False(t, actual.Found)
+}
+
+func Test_Search_TestSearchSameNode(t *testing.T) {
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](newTestTypedGraph())
+
+	actual := uc.Find("a", "a")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 0, actual.Cost)
+	assert.Equal(t, []string{"a"}, actual.Vertices)
+	assert.Empty(t, actual.Edges)
+}
+
+func Test_Search_TestShortestPathFound(t *testing.T) {
+	graph := newTestTypedGraph()
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](graph)
+
+	actual := uc.Find("a", "g")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 8, actual.Cost)
+	assert.Equal(t, []string{"a", "d", "f", "g"}, actual.Vertices)
+	assert.Equal(t, []*testTypedEdge{graph.edges["a"][0], graph.edges["d"][1], graph.edges["f"][0]}, actual.Edges)
+}
+
+func Test_Search_TestFuncGraph(t *testing.T) {
+	graph := newTestTypedGraph()
+	uc := shortest_path.NewUniformCost(shortest_path.NewFuncGraph(graph.Edges, graph.EdgeEnd, graph.EdgeCost))
+
+	actual := uc.Find("b", "g")
+	assert.True(t, actual.Found)
+	assert.Equal(t, 9, actual.Cost)
+	assert.Equal(t, []string{"b", "c", "g"}, actual.Vertices)
+}
+
+func Test_Search_TestInterfaceGraph(t *testing.T) {
+	graph := &testByInterfaceGraph{}
+	graph.buildTestByInterfaceGraph()
+
+	uc := shortest_path.NewUniformCost(shortest_path.NewInterfaceGraph())
+
+	actual := uc.Find(graph.vs["a"], graph.vs["g"])
+	assert.True(t, actual.Found)
+	assert.Equal(t, 8, actual.Cost)
+	assert.Equal(t, []shortest_path.Vertex{graph.vs["a"], graph.vs["d"], graph.vs["f"], graph.vs["g"]}, actual.Vertices)
+	assert.Len(t, actual.Edges, 3)
+	assert.Equal(t, graph.vs["d"], actual.Edges[1].From())
+}
@@ -0,0 +1,138 @@
+package shortest_path
+
+// Tree of shortest paths from Source to every reachable vertex
+type Tree[V comparable, E any, C any] struct {
+	Source V
+
+	// Cost of the cheapest path to every reachable vertex
+	Cost map[V]C
+	// Predecessor of every reachable vertex except Source on its cheapest path
+	Predecessor map[V]V
+	// PredecessorEdge leads from Predecessor[v] to v
+	PredecessorEdge map[V]E
+
+	// Stats of the search
+	Stats Stats
+
+	// Err is set when the search could not complete, the tree is empty
+	Err error
+}
+
+func newTree[V comparable, E any, C any](source V) *Tree[V, E, C] {
+	return &Tree[V, E, C]{
+		Source:          source,
+		Cost:            map[V]C{},
+		Predecessor:     map[V]V{},
+		PredecessorEdge: map[V]E{},
+	}
+}
+
+func (t *Tree[V, E, C]) add(n *node[V, E, C]) {
+	t.Cost[n.vertex] = n.totalCost
+	if n.parent != nil {
+		t.Predecessor[n.vertex] = n.parent.vertex
+		t.PredecessorEdge[n.vertex] = n.edge
+	}
+}
+
+// PathTo returns the cheapest path from Source to vertex
+func (t *Tree[V, E, C]) PathTo(vertex V) *Path[V, E, C] {
+	cost, found := t.Cost[vertex]
+	if !found {
+		return &Path[V, E, C]{Found: false, Err: t.Err}
+	}
+
+	vertices := []V{vertex}
+	edges := []E{}
+	for v := vertex; v != t.Source; v = t.Predecessor[v] {
+		vertices = append(vertices, t.Predecessor[v])
+		edges = append(edges, t.PredecessorEdge[v])
+	}
+	for i, j := 0, len(vertices)-1; i < j; i, j = i+1, j-1 {
+		vertices[i], vertices[j] = vertices[j], vertices[i]
+	}
+	for i, j := 0, len(edges)-1; i < j; i, j = i+1, j-1 {
+		edges[i], edges[j] = edges[j], edges[i]
+	}
+
+	return &Path[V, E, C]{
+		Found: true,
+
+		Cost:     cost,
+		Vertices: vertices,
+		Edges:    edges,
+	}
+}
+
+// FindAll searches until every vertex reachable from the source is settled
+// and returns the tree of cheapest paths, heuristics are not used
+func (s *Search[V, E, C]) FindAll(from V) *Tree[V, E, C] {
+	tree := newTree[V, E, C](from)
+	probe := s.newProbe()
+
+	if s.bellmanFord {
+		best, _, err := s.relaxAll([]V{from}, nil, probe)
+		tree.Stats = probe.stats
+		if err != nil {
+			tree.Err = err
+			return tree
+		}
+		for _, n := range best {
+			tree.add(n)
+		}
+		return tree
+	}
+
+	noEstimate := func(V) C {
+		var zero C
+		return zero
+	}
+	_, err := s.expand([]V{from}, noEstimate, nil, probe, func(n *node[V, E, C]) bool {
+		tree.add(n)
+		return false
+	})
+	if err != nil {
+		tree = newTree[V, E, C](from)
+		tree.Err = err
+	}
+	tree.Stats = probe.stats
+
+	return tree
+}
+
+// ResultTree is the untyped form of Tree
+type ResultTree struct {
+	Source interface{}
+
+	Cost        map[interface{}]int
+	Predecessor map[interface{}]interface{}
+
+	Stats Stats
+	Err   error
+
+	pathTo func(vertex interface{}) *Result
+}
+
+// PathTo returns the cheapest path from Source to vertex
+func (t *ResultTree) PathTo(vertex interface{}) *Result {
+	return t.pathTo(vertex)
+}
+
+// toResultTree converts a typed Tree to an untyped ResultTree
+func toResultTree[V comparable, E any](tree *Tree[V, E, int], pathTo func(vertex interface{}) *Result) *ResultTree {
+	result := &ResultTree{
+		Source:      tree.Source,
+		Cost:        map[interface{}]int{},
+		Predecessor: map[interface{}]interface{}{},
+		Stats:       tree.Stats,
+		Err:         tree.Err,
+		pathTo:      pathTo,
+	}
+	for v, cost := range tree.Cost {
+		result.Cost[v] = cost
+	}
+	for v, p := range tree.Predecessor {
+		result.Predecessor[v] = p
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package shortest_path_test
+
+import (
+	"errors"
+	"fatdes/go_algo/shortest_path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FindAll_TestShortestPathTree(t *testing.T) {
+	graph := newTestTypedGraph()
+	uc := shortest_path.NewUniformCost[string, *testTypedEdge](graph)
+
+	tree := uc.FindAll("a")
+	assert.NoError(t, tree.Err)
+	assert.Equal(t, "a", tree.Source)
+	assert.Equal(t, map[string]int{"a": 0, "b": 5, "c": 6, "d": 3, "e": 5, "f": 5, "g": 8}, tree.Cost)
+	assert.Equal(t, map[string]string{"b": "a", "c": "b", "d": "a", "e": "d", "f": "d", "g": "f"}, tree.Predecessor)
+	assert.Equal(t, graph.edges["f"][0], tree.PredecessorEdge["g"])
+
+	for v, cost := range tree.Cost {
+		expected := uc.Find("a", v)
+		actual := tree.PathTo(v)
+		assert.True(t, actual.Found)
+		assert.Equal(t, cost, actual.Cost)
+		assert.Equal(t, expected.Vertices, actual.Vertices)
+		assert.Equal(t, expected.Edges, actual.Edges)
+	}
+
+	assert.False(t, tree.PathTo("h").Found)
+}
+
+func Test_FindAll_TestNegativeCost(t *testing.T) {
+	graph := newTestNegativeGraph()
+
+	tree := shortest_path.NewUniformCost[string, *testTypedEdge](graph).FindAll("a")
+	assert.True(t, errors.Is(tree.Err, shortest_path.ErrNegativeCost))
+	assert.Empty(t, tree.Cost)
+	assert.True(t, errors.Is(tree.PathTo("a").Err, shortest_path.ErrNegativeCost))
+
+	tree = shortest_path.NewBellmanFord[string, *testTypedEdge](graph).FindAll("a")
+	assert.NoError(t, tree.Err)
+	assert.Equal(t, map[string]int{"a": 0, "b": -1, "c": 2, "d": 0}, tree.Cost)
+	assert.Equal(t, []string{"a", "c", "b", "d"}, tree.PathTo("d").Vertices)
+
+	graph.addEdge("d", "c", 1)
+	tree = shortest_path.NewBellmanFord[string, *testTypedEdge](graph).FindAll("a")
+	var cycleErr *shortest_path.NegativeCycleError[string]
+	assert.True(t, errors.As(tree.Err, &cycleErr))
+}
+
+func Test_FindAll_TestByFunc(t *testing.T) {
+	graph := &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+	graph.buildTestByFuncGraph()
+
+	uc := shortest_path.NewUniformCostByFunc(graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost)
+
+	tree := uc.FindAll("b")
+	assert.Equal(t, map[interface{}]int{"b": 0, "c": 1, "e": 7, "g": 9}, tree.Cost)
+	assert.Equal(t, map[interface{}]interface{}{"c": "b", "e": "c", "g": "c"}, tree.Predecessor)
+	assert.Equal(t, "b,c,g", ByFuncString(tree.PathTo("g").Path))
+	assert.False(t, tree.PathTo("a").Found)
+
+	assert.Empty(t, uc.FindAll(nil).Cost)
+}
+
+func Test_FindAll_TestByInterface(t *testing.T) {
+	graph := &testByInterfaceGraph{}
+	graph.buildTestByInterfaceGraph()
+
+	uc := shortest_path.NewUniformCostByInterface().(shortest_path.TreeFinder)
+
+	tree := uc.FindAll(graph.vs["a"])
+	assert.Len(t, tree.Cost, 7)
+	assert.Equal(t, 8, tree.Cost[graph.vs["g"]])
+	assert.Equal(t, graph.vs["f"], tree.Predecessor[graph.vs["g"]])
+	assert.Equal(t, "a,d,f,g", ByInterfaceString(tree.PathTo(graph.vs["g"]).Path))
+	assert.False(t, tree.PathTo("g").Found)
+
+	assert.Empty(t, uc.FindAll("a").Cost)
+}
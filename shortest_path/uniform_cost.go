@@ -1,22 +1,109 @@
 package shortest_path
 
+import (
+	"context"
+)
+
 type Result struct {
 	Found bool
 
 	Cost int
 	Path []interface{}
+	// Edges[i] leads from Path[i] to Path[i+1]
+	Edges []interface{}
+
+	// Source and Goal are the first and last vertex of a found path, they
+	// tell which source and goal FindNearest used
+	Source interface{}
+	Goal   interface{}
+
+	// Resources used by a FindConstrained path, in the order of Constraints.Resources
+	Resources []int
+
+	// Stats of the search, also when nothing is found
+	Stats Stats
+
+	// Err is set when the search could not complete, Found is false
+	Err error
 }
 
+// UniformCost is the untyped search API. Its implementations keep no state
+// between calls, so one may be used by many goroutines at once as long as
+// the graph functions, or the Vertex and Edge methods, are safe to call
+// concurrently and the graph does not change during a search. An Observer
+// is called from every goroutine searching and must synchronise itself
 type UniformCost interface {
 	Find(from, to interface{}) *Result
 }
 
-type node struct {
-	vertex    interface{}
-	totalCost int
-	path      []interface{}
+// The searches of the ByFunc and ByInterface constructors have more
+// operations than Find, each in an interface of its own so that UniformCost
+// stays small enough to implement outside of this package
+
+// ContextFinder searches until ctx is done or options stop it
+type ContextFinder interface {
+	FindContext(ctx context.Context, from, to interface{}, options *Options[int]) *Result
+}
+
+// KFinder finds the k cheapest paths
+type KFinder interface {
+	FindK(from, to interface{}, k int) []*Result
+}
+
+// TreeFinder finds the cheapest paths to every vertex reachable from a source
+type TreeFinder interface {
+	FindAll(from interface{}) *ResultTree
+}
+
+// NearestFinder finds the cheapest path from any source to any goal
+type NearestFinder interface {
+	FindNearest(sources []interface{}, goal func(vertex interface{}) bool) *Result
+}
+
+// ConstrainedFinder finds the cheapest path within Constraints
+type ConstrainedFinder interface {
+	FindConstrained(from, to interface{}, constraints *Constraints[interface{}]) *Result
+}
+
+// Observable searches report their steps to an Observer
+type Observable interface {
+	// WithObserver returns a copy of the search that reports its steps to observer
+	WithObserver(observer Observer[interface{}, interface{}, int]) UniformCost
+}
+
+// toResult converts a typed Path to an untyped Result
+func toResult[V comparable, E any](path *Path[V, E, int]) *Result {
+	if !path.Found {
+		return &Result{Found: false, Stats: path.Stats, Err: path.Err}
+	}
+
+	vertices := make([]interface{}, len(path.Vertices))
+	for i, v := range path.Vertices {
+		vertices[i] = v
+	}
+
+	edges := make([]interface{}, len(path.Edges))
+	for i, e := range path.Edges {
+		edges[i] = e
+	}
+
+	return &Result{
+		Found:  true,
+		Cost:   path.Cost,
+		Path:   vertices,
+		Edges:  edges,
+		Source: path.Source(),
+		Goal:   path.Goal(),
+
+		Resources: path.Resources,
+		Stats:     path.Stats,
+	}
 }
 
-func (n *node) cost() int {
-	return n.totalCost
+func toResults[V comparable, E any](paths []*Path[V, E, int]) []*Result {
+	results := make([]*Result, len(paths))
+	for i, path := range paths {
+		results[i] = toResult(path)
+	}
+	return results
 }
@@ -0,0 +1,128 @@
+// Package spanning_tree finds minimum spanning trees of the graphs searched
+// by the shortest_path package. Edges are undirected: an edge listed by
+// either of its ends connects both
+package spanning_tree
+
+import (
+	"fatdes/go_algo/shortest_path"
+)
+
+// Tree spans one connected component
+type Tree[V comparable, E any, C shortest_path.Numeric] struct {
+	// Vertices of the component, those given first in their order, then
+	// the ones reached through them
+	Vertices []V
+	Edges    []E
+	Cost     C
+}
+
+// Forest is a minimum spanning forest, one Tree per connected component. A
+// connected graph has a single Tree
+type Forest[V comparable, E any, C shortest_path.Numeric] struct {
+	// Edges of every Tree, in the order they were chosen
+	Edges []E
+	Cost  C
+	Trees []*Tree[V, E, C]
+}
+
+// Connected returns true if the forest is a single spanning tree
+func (f *Forest[V, E, C]) Connected() bool {
+	return len(f.Trees) <= 1
+}
+
+// incidence is an edge seen from one of its ends
+type incidence[E any] struct {
+	edge  E
+	other int
+}
+
+// undirected indexes a graph with dense vertex ids and the edges of every
+// vertex in both directions
+type undirected[V comparable, E any, C shortest_path.Numeric] struct {
+	graph    shortest_path.Graph[V, E, C]
+	vertices []V
+	ids      map[V]int
+	edges    [][]incidence[E]
+}
+
+// index finds vertices and every vertex reachable from them, self loops are
+// dropped. predecessors returns the starts of the edges ending at a vertex,
+// it finds the vertices reaching them too and may be nil
+func index[V comparable, E any, C shortest_path.Numeric](graph shortest_path.Graph[V, E, C], vertices []V, predecessors func(V) []V) *undirected[V, E, C] {
+	u := &undirected[V, E, C]{graph: graph, ids: map[V]int{}}
+	for _, v := range vertices {
+		u.id(v)
+	}
+
+	// vertices are appended while their edges are read, so this visits all
+	// of them. Edges are indexed from their start only
+	for from := 0; from < len(u.vertices); from++ {
+		if predecessors != nil {
+			for _, v := range predecessors(u.vertices[from]) {
+				u.id(v)
+			}
+		}
+		for _, edge := range graph.Edges(u.vertices[from]) {
+			to := u.id(graph.EdgeEnd(edge))
+			if to == from {
+				continue
+			}
+			u.edges[from] = append(u.edges[from], incidence[E]{edge: edge, other: to})
+			u.edges[to] = append(u.edges[to], incidence[E]{edge: edge, other: from})
+		}
+	}
+	return u
+}
+
+func (u *undirected[V, E, C]) id(v V) int {
+	id, found := u.ids[v]
+	if !found {
+		id = len(u.vertices)
+		u.ids[v] = id
+		u.vertices = append(u.vertices, v)
+		u.edges = append(u.edges, nil)
+	}
+	return id
+}
+
+// forest groups the chosen edges by component. component[id] is the
+// component of every vertex, numbered in the order of their first vertex,
+// ends[i] is a vertex of chosen[i]
+func (u *undirected[V, E, C]) forest(component []int, chosen []E, ends []int) *Forest[V, E, C] {
+	f := &Forest[V, E, C]{Edges: []E{}, Trees: []*Tree[V, E, C]{}}
+	for id, c := range component {
+		if c == len(f.Trees) {
+			f.Trees = append(f.Trees, &Tree[V, E, C]{Edges: []E{}})
+		}
+		f.Trees[c].Vertices = append(f.Trees[c].Vertices, u.vertices[id])
+	}
+	for i, edge := range chosen {
+		cost := u.graph.EdgeCost(edge)
+		tree := f.Trees[component[ends[i]]]
+		tree.Edges = append(tree.Edges, edge)
+		tree.Cost += cost
+		f.Edges = append(f.Edges, edge)
+		f.Cost += cost
+	}
+	return f
+}
+
+// predecessorsOf walks graph backwards if it is a ReverseGraph
+func predecessorsOf[V comparable, E any, C shortest_path.Numeric](graph shortest_path.Graph[V, E, C]) func(V) []V {
+	reverse, ok := graph.(shortest_path.ReverseGraph[V, E, C])
+	if !ok {
+		return nil
+	}
+	return func(vertex V) []V {
+		starts := []V{}
+		for _, edge := range reverse.InEdges(vertex) {
+			starts = append(starts, reverse.EdgeStart(edge))
+		}
+		return starts
+	}
+}
+
+// byFunc adapts the untyped adjacency functions of shortest_path
+func byFunc(edges func(interface{}) []interface{}, edgeEnd func(interface{}) interface{}, edgeCost func(interface{}) int) shortest_path.Graph[interface{}, interface{}, int] {
+	return shortest_path.NewFuncGraph(edges, edgeEnd, edgeCost)
+}
@@ -0,0 +1,195 @@
+package spanning_tree_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/shortest_path/adjacency"
+	"fatdes/go_algo/spanning_tree"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testByFuncGraph struct {
+	edges     map[interface{}][]interface{}
+	edgeCosts map[interface{}]int
+}
+
+func newTestByFuncGraph() *testByFuncGraph {
+	return &testByFuncGraph{edges: map[interface{}][]interface{}{}, edgeCosts: map[interface{}]int{}}
+}
+
+func (graph *testByFuncGraph) getEdges(from interface{}) []interface{} {
+	return graph.edges[from]
+}
+
+func (graph *testByFuncGraph) getEdgeEnd(edge interface{}) interface{} {
+	return strings.Split(edge.(string), "_")[1]
+}
+
+func (graph *testByFuncGraph) getEdgeCost(edge interface{}) int {
+	return graph.edgeCosts[edge]
+}
+
+// addEdge lists the edge at from only, spanning trees use it both ways
+func (graph *testByFuncGraph) addEdge(from, to string, cost int) *testByFuncGraph {
+	edge := fmt.Sprintf("%s_%s", from, to)
+	graph.edges[from] = append(graph.edges[from], edge)
+	graph.edgeCosts[edge] = cost
+	return graph
+}
+
+// newTestCableGraph is the usual example with a minimum spanning tree costing 39
+func newTestCableGraph() *testByFuncGraph {
+	graph := newTestByFuncGraph()
+	graph.addEdge("a", "b", 7).addEdge("a", "d", 5)
+	graph.addEdge("b", "c", 8).addEdge("b", "d", 9).addEdge("b", "e", 7)
+	graph.addEdge("c", "e", 5)
+	graph.addEdge("d", "e", 15).addEdge("d", "f", 6)
+	graph.addEdge("e", "f", 8).addEdge("e", "g", 9)
+	graph.addEdge("f", "g", 11)
+	return graph
+}
+
+func sortedEdges(edges []interface{}) []string {
+	names := make([]string, len(edges))
+	for i, edge := range edges {
+		names[i] = edge.(string)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newTestRandomGraph is a random graph with a fixed seed, two way edges are
+// listed at both ends like adjacency.Graph.AddUndirectedEdge does
+func newTestRandomGraph(vertices, edges int, seed int64) *adjacency.Graph[int] {
+	r := rand.New(rand.NewSource(seed))
+	g := adjacency.New[int]()
+	for v := 0; v < vertices; v++ {
+		g.AddVertex(v)
+	}
+	for i := 0; i < edges; i++ {
+		a, b, cost := r.Intn(vertices), r.Intn(vertices), r.Intn(50)-10
+		if r.Intn(2) == 0 {
+			g.AddUndirectedEdge(a, b, cost)
+		} else {
+			g.AddEdge(a, b, cost)
+		}
+	}
+	return g
+}
+
+func testVertices(g *adjacency.Graph[int]) []shortest_path.Vertex {
+	vertices := []shortest_path.Vertex{}
+	for _, v := range g.Vertices() {
+		vertices = append(vertices, v)
+	}
+	return vertices
+}
+
+// assertForest checks every tree of forest spans its component without cycles
+func assertForest(t *testing.T, forest *spanning_tree.Forest[shortest_path.Vertex, shortest_path.Edge, int]) {
+	total, edges := 0, 0
+	seen := map[shortest_path.Vertex]int{}
+	for i, tree := range forest.Trees {
+		assert.Len(t, tree.Edges, len(tree.Vertices)-1)
+		cost := 0
+		for _, v := range tree.Vertices {
+			_, found := seen[v]
+			assert.False(t, found)
+			seen[v] = i
+		}
+		for _, edge := range tree.Edges {
+			assert.Equal(t, i, seen[edge.From()])
+			assert.Equal(t, i, seen[edge.To()])
+			cost += edge.Cost()
+		}
+		assert.Equal(t, cost, tree.Cost)
+		total += cost
+		edges += len(tree.Edges)
+	}
+	assert.Equal(t, total, forest.Cost)
+	assert.Len(t, forest.Edges, edges)
+}
+
+func Test_Forest_TestComponents(t *testing.T) {
+	g := adjacency.New[string]()
+	g.AddUndirectedEdge("a", "b", 1)
+	g.AddEdge("c", "d", 2)
+	g.AddVertex("e")
+	a, _ := g.Vertex("a")
+	c, _ := g.Vertex("c")
+	d, _ := g.Vertex("d")
+	e, _ := g.Vertex("e")
+
+	// d is only reached through c, it still joins the tree of c
+	for _, forest := range []*spanning_tree.Forest[shortest_path.Vertex, shortest_path.Edge, int]{
+		spanning_tree.PrimByInterface(d, a, e),
+		spanning_tree.KruskalByInterface(d, a, e),
+	} {
+		assert.False(t, forest.Connected())
+		assert.Len(t, forest.Trees, 3)
+		assert.Equal(t, 3, forest.Cost)
+		assert.Equal(t, []shortest_path.Vertex{d, c}, forest.Trees[0].Vertices)
+		assert.Equal(t, 2, forest.Trees[0].Cost)
+		assert.Len(t, forest.Trees[1].Vertices, 2)
+		assert.Equal(t, []shortest_path.Vertex{e}, forest.Trees[2].Vertices)
+		assert.Empty(t, forest.Trees[2].Edges)
+		assertForest(t, forest)
+	}
+}
+
+func Test_Forest_TestEmpty(t *testing.T) {
+	forest := spanning_tree.PrimByInterface()
+	assert.True(t, forest.Connected())
+	assert.Empty(t, forest.Trees)
+	assert.Empty(t, forest.Edges)
+	assert.Equal(t, 0, spanning_tree.KruskalByInterface().Cost)
+}
+
+// testPlainVertex does not know its in-edges
+type testPlainVertex struct {
+	id    string
+	edges []shortest_path.Edge
+}
+
+func (v *testPlainVertex) Edges() []shortest_path.Edge {
+	return v.edges
+}
+
+type testPlainEdge struct {
+	cost     int
+	from, to *testPlainVertex
+}
+
+func (e *testPlainEdge) Cost() int                  { return e.cost }
+func (e *testPlainEdge) From() shortest_path.Vertex { return e.from }
+func (e *testPlainEdge) To() shortest_path.Vertex   { return e.to }
+
+func Test_Forest_TestWithoutInVertex(t *testing.T) {
+	a, b, c := &testPlainVertex{id: "a"}, &testPlainVertex{id: "b"}, &testPlainVertex{id: "c"}
+	a.edges = []shortest_path.Edge{&testPlainEdge{cost: 1, from: a, to: b}, &testPlainEdge{cost: 5, from: a, to: c}}
+	b.edges = []shortest_path.Edge{&testPlainEdge{cost: 2, from: b, to: c}}
+
+	for _, find := range []func(...shortest_path.Vertex) *spanning_tree.Forest[shortest_path.Vertex, shortest_path.Edge, int]{
+		spanning_tree.PrimByInterface, spanning_tree.KruskalByInterface,
+	} {
+		// the vertices reaching c are unknown
+		forest := find(c)
+		assert.Equal(t, []shortest_path.Vertex{c}, forest.Trees[0].Vertices)
+		assert.Empty(t, forest.Edges)
+
+		forest = find(a)
+		assert.True(t, forest.Connected())
+		assert.Equal(t, 3, forest.Cost)
+	}
+
+	// c is joined to a once a is found
+	forest := spanning_tree.Prim(shortest_path.NewInterfaceGraph(), []shortest_path.Vertex{c, a})
+	assert.True(t, forest.Connected())
+	assert.Equal(t, []shortest_path.Vertex{c, a, b}, forest.Trees[0].Vertices)
+	assert.Equal(t, 3, forest.Cost)
+}
@@ -0,0 +1,106 @@
+package spanning_tree
+
+import (
+	"sort"
+
+	"fatdes/go_algo/shortest_path"
+)
+
+// unionFind is a disjoint set forest over dense ids
+type unionFind struct {
+	parent []int
+	rank   []int
+}
+
+func newUnionFind(n int) *unionFind {
+	uf := &unionFind{parent: make([]int, n), rank: make([]int, n)}
+	for id := range uf.parent {
+		uf.parent[id] = id
+	}
+	return uf
+}
+
+// find returns the representative of the set of id, halving the path to it
+func (uf *unionFind) find(id int) int {
+	for uf.parent[id] != id {
+		uf.parent[id] = uf.parent[uf.parent[id]]
+		id = uf.parent[id]
+	}
+	return id
+}
+
+// union joins the sets of a and b, it returns false if they were one already
+func (uf *unionFind) union(a, b int) bool {
+	a, b = uf.find(a), uf.find(b)
+	if a == b {
+		return false
+	}
+	if uf.rank[a] < uf.rank[b] {
+		a, b = b, a
+	}
+	uf.parent[b] = a
+	if uf.rank[a] == uf.rank[b] {
+		uf.rank[a]++
+	}
+	return true
+}
+
+// Kruskal picks the cheapest edges that join two trees, equal costs in the
+// order the edges were found. Vertices are spanned like by Prim, edge costs
+// may be negative
+func Kruskal[V comparable, E any, C shortest_path.Numeric](graph shortest_path.Graph[V, E, C], vertices []V) *Forest[V, E, C] {
+	u := index(graph, vertices, predecessorsOf(graph))
+	n := len(u.vertices)
+
+	type weighted struct {
+		from, to int
+		edge     E
+		cost     C
+	}
+	// every edge is indexed at both ends, it is taken from the lower one
+	edges := []weighted{}
+	for from := 0; from < n; from++ {
+		for _, edge := range u.edges[from] {
+			if from < edge.other {
+				edges = append(edges, weighted{from: from, to: edge.other, edge: edge.edge, cost: graph.EdgeCost(edge.edge)})
+			}
+		}
+	}
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].cost < edges[j].cost
+	})
+
+	uf := newUnionFind(n)
+	chosen, ends := []E{}, []int{}
+	for _, edge := range edges {
+		if uf.union(edge.from, edge.to) {
+			chosen = append(chosen, edge.edge)
+			ends = append(ends, edge.from)
+		}
+	}
+
+	// components are numbered in the order of their first vertex
+	component := make([]int, n)
+	numbers := map[int]int{}
+	for id := range component {
+		root := uf.find(id)
+		number, found := numbers[root]
+		if !found {
+			number = len(numbers)
+			numbers[root] = number
+		}
+		component[id] = number
+	}
+
+	return u.forest(component, chosen, ends)
+}
+
+// KruskalByFunc is Kruskal over the untyped adjacency functions of NewUniformCostByFunc
+func KruskalByFunc(vertices []interface{}, edges func(interface{}) []interface{}, edgeEnd func(interface{}) interface{}, edgeCost func(interface{}) int) *Forest[interface{}, interface{}, int] {
+	return Kruskal(byFunc(edges, edgeEnd, edgeCost), vertices)
+}
+
+// KruskalByInterface is Kruskal over the Vertex and Edge interfaces
+func KruskalByInterface(vertices ...shortest_path.Vertex) *Forest[shortest_path.Vertex, shortest_path.Edge, int] {
+	return Kruskal(shortest_path.NewInterfaceGraph(), vertices)
+}
@@ -0,0 +1,46 @@
+package spanning_tree_test
+
+import (
+	"fatdes/go_algo/spanning_tree"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Kruskal_TestByFunc(t *testing.T) {
+	graph := newTestCableGraph()
+
+	forest := spanning_tree.KruskalByFunc([]interface{}{"a"}, graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost)
+	assert.True(t, forest.Connected())
+	assert.Equal(t, 39, forest.Cost)
+	// cheapest first, ties in the order the edges were found
+	assert.Equal(t, []interface{}{"a_d", "c_e", "d_f", "a_b", "b_e", "e_g"}, forest.Edges)
+}
+
+func Test_Kruskal_TestSameAsPrim(t *testing.T) {
+	for seed := int64(1); seed <= 20; seed++ {
+		g := newTestRandomGraph(60, 80+int(seed)*5, seed)
+		kruskal := spanning_tree.KruskalByInterface(testVertices(g)...)
+		prim := spanning_tree.PrimByInterface(testVertices(g)...)
+
+		assertForest(t, kruskal)
+		assert.Equal(t, prim.Cost, kruskal.Cost, seed)
+		assert.Equal(t, len(prim.Trees), len(kruskal.Trees), seed)
+		for i := range prim.Trees {
+			assert.Equal(t, prim.Trees[i].Vertices, kruskal.Trees[i].Vertices, seed)
+			assert.Equal(t, prim.Trees[i].Cost, kruskal.Trees[i].Cost, seed)
+		}
+	}
+}
+
+func Test_Kruskal_TestParallelEdges(t *testing.T) {
+	graph := newTestByFuncGraph()
+	graph.addEdge("a", "b", 4).addEdge("b", "a", 2).addEdge("a", "a", -5)
+
+	forest := spanning_tree.KruskalByFunc([]interface{}{"a"}, graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost)
+	assert.Equal(t, []interface{}{"b_a"}, forest.Edges)
+	assert.Equal(t, 2, forest.Cost)
+
+	forest = spanning_tree.PrimByFunc([]interface{}{"a"}, graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost)
+	assert.Equal(t, []interface{}{"b_a"}, forest.Edges)
+}
@@ -0,0 +1,83 @@
+package spanning_tree
+
+import (
+	"container/heap"
+
+	"fatdes/go_algo/shortest_path"
+)
+
+// candidate is the cheapest edge found so far joining vertex to the tree
+type candidate[E any, C shortest_path.Numeric] struct {
+	vertex int
+	edge   E
+	cost   C
+}
+
+// Prim grows a minimum spanning tree from every vertex not yet spanned, in
+// the order of vertices. Vertices reachable from them are spanned too, and
+// those reaching them when graph is a ReverseGraph or has InVertex vertices.
+// Edge costs may be negative
+func Prim[V comparable, E any, C shortest_path.Numeric](graph shortest_path.Graph[V, E, C], vertices []V) *Forest[V, E, C] {
+	u := index(graph, vertices, predecessorsOf(graph))
+	n := len(u.vertices)
+
+	// component is -1 until the vertex joins a tree
+	component := make([]int, n)
+	for id := range component {
+		component[id] = -1
+	}
+	chosen, ends := []E{}, []int{}
+
+	less := func(value, other interface{}) bool {
+		return value.(*candidate[E, C]).cost < other.(*candidate[E, C]).cost
+	}
+	// queued is the queue entry of every vertex next to the tree
+	queued := make([]*shortest_path.Item, n)
+	pq := shortest_path.PriorityQueue{}
+	connect := func(id int) {
+		for _, edge := range u.edges[id] {
+			if component[edge.other] >= 0 {
+				continue
+			}
+			next := &candidate[E, C]{vertex: edge.other, edge: edge.edge, cost: graph.EdgeCost(edge.edge)}
+			if item := queued[edge.other]; item != nil {
+				if next.cost < item.Value().(*candidate[E, C]).cost {
+					pq.UpdateValue(item, next)
+				}
+				continue
+			}
+			queued[edge.other] = shortest_path.NewLessItem(next, less)
+			heap.Push(&pq, queued[edge.other])
+		}
+	}
+
+	trees := 0
+	for root := 0; root < n; root++ {
+		if component[root] >= 0 {
+			continue
+		}
+		component[root] = trees
+		connect(root)
+		for pq.Len() > 0 {
+			c := heap.Pop(&pq).(*shortest_path.Item).Value().(*candidate[E, C])
+			queued[c.vertex] = nil
+			component[c.vertex] = trees
+			chosen = append(chosen, c.edge)
+			ends = append(ends, c.vertex)
+			connect(c.vertex)
+		}
+		trees++
+	}
+
+	return u.forest(component, chosen, ends)
+}
+
+// PrimByFunc is Prim over the untyped adjacency functions of NewUniformCostByFunc
+func PrimByFunc(vertices []interface{}, edges func(interface{}) []interface{}, edgeEnd func(interface{}) interface{}, edgeCost func(interface{}) int) *Forest[interface{}, interface{}, int] {
+	return Prim(byFunc(edges, edgeEnd, edgeCost), vertices)
+}
+
+// PrimByInterface is Prim over the Vertex and Edge interfaces
+func PrimByInterface(vertices ...shortest_path.Vertex) *Forest[shortest_path.Vertex, shortest_path.Edge, int] {
+	return Prim(shortest_path.NewInterfaceGraph(), vertices)
+}
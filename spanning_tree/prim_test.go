@@ -0,0 +1,55 @@
+package spanning_tree_test
+
+import (
+	"fatdes/go_algo/shortest_path"
+	"fatdes/go_algo/spanning_tree"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Prim_TestByFunc(t *testing.T) {
+	graph := newTestCableGraph()
+
+	// edges are listed at one end only, a reaches every vertex through them
+	forest := spanning_tree.PrimByFunc([]interface{}{"a"}, graph.getEdges, graph.getEdgeEnd, graph.getEdgeCost)
+	assert.True(t, forest.Connected())
+	assert.Equal(t, 39, forest.Cost)
+	assert.Equal(t, []string{"a_b", "a_d", "b_e", "c_e", "d_f", "e_g"}, sortedEdges(forest.Edges))
+	assert.Equal(t, []interface{}{"a", "b", "d", "c", "e", "f", "g"}, forest.Trees[0].Vertices)
+	// edges are chosen as the tree grows from a
+	assert.Equal(t, "a_d", forest.Edges[0])
+}
+
+func Test_Prim_TestByInterface(t *testing.T) {
+	g := newTestRandomGraph(100, 150, 1)
+	forest := spanning_tree.PrimByInterface(testVertices(g)...)
+	assertForest(t, forest)
+	assert.Greater(t, len(forest.Trees), 1)
+}
+
+func Test_Prim_TestTyped(t *testing.T) {
+	// a float cost complete graph of points on a line, the tree links neighbours
+	points := []float64{0, 2.5, 3, 7.25}
+	graph := shortest_path.NewFuncGraph(
+		func(v int) [][2]int {
+			edges := [][2]int{}
+			for w := range points {
+				edges = append(edges, [2]int{v, w})
+			}
+			return edges
+		},
+		func(edge [2]int) int { return edge[1] },
+		func(edge [2]int) float64 {
+			d := points[edge[0]] - points[edge[1]]
+			if d < 0 {
+				return -d
+			}
+			return d
+		},
+	)
+
+	forest := spanning_tree.Prim[int, [2]int, float64](graph, []int{0})
+	assert.Equal(t, 7.25, forest.Cost)
+	assert.Len(t, forest.Edges, 3)
+}